@@ -1206,3 +1206,109 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.WithEvmParamsOptions(nil)
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerMultipleEthMsgs() {
+	to := utiltx.GenerateAddress()
+	evmChainID := evmtypes.GetEthChainConfig().ChainID
+
+	// generateMsg returns a signed MsgEthereumTx for the given keyring
+	// account and nonce.
+	generateMsg := func(keyIdx int, nonce uint64) *evmtypes.MsgEthereumTx {
+		msg, err := suite.GetTxFactory().GenerateSignedMsgEthereumTx(
+			suite.GetKeyring().GetPrivKey(keyIdx),
+			evmtypes.EvmTxArgs{
+				ChainID:   evmChainID,
+				Nonce:     nonce,
+				To:        &to,
+				Amount:    big.NewInt(10),
+				GasLimit:  100000,
+				GasFeeCap: big.NewInt(ethparams.InitialBaseFee + 1),
+				GasTipCap: big.NewInt(1),
+				Accesses:  &types.AccessList{},
+			},
+		)
+		suite.Require().NoError(err)
+		return &msg
+	}
+
+	testCases := []struct {
+		name      string
+		msgsFn    func() []*evmtypes.MsgEthereumTx
+		checkTx   bool
+		expPass   bool
+		expNonces []uint64
+	}{
+		{
+			"success - DeliverTx same sender with consecutive nonces",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{generateMsg(0, 0), generateMsg(0, 1)}
+			},
+			false, true,
+			[]uint64{2, 0},
+		},
+		{
+			"success - CheckTx same sender with consecutive nonces",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{generateMsg(0, 0), generateMsg(0, 1)}
+			},
+			true, true,
+			[]uint64{2, 0},
+		},
+		{
+			"success - DeliverTx multiple senders",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{generateMsg(0, 0), generateMsg(1, 0), generateMsg(0, 1)}
+			},
+			false, true,
+			[]uint64{2, 1},
+		},
+		{
+			"fail - same sender with repeated nonce",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{generateMsg(0, 0), generateMsg(0, 0)}
+			},
+			false, false, nil,
+		},
+		{
+			"fail - nonce gap between msgs",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{generateMsg(0, 0), generateMsg(0, 2)}
+			},
+			false, false, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.WithFeemarketEnabled(true)
+			suite.SetupTest() // reset
+
+			ctx := suite.GetNetwork().GetContext().WithIsCheckTx(tc.checkTx)
+			msgs := tc.msgsFn()
+			tx, err := evmtypes.BuildTxWithMsgs(
+				suite.GetClientCtx().TxConfig.NewTxBuilder(),
+				evmtypes.GetEVMCoinDenom(),
+				msgs...,
+			)
+			suite.Require().NoError(err)
+
+			anteHandler := suite.GetAnteHandler()
+			newCtx, err := anteHandler(ctx, tx, false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			for i, expNonce := range tc.expNonces {
+				acc := suite.GetNetwork().App.AccountKeeper.GetAccount(newCtx, suite.GetKeyring().GetAccAddr(i))
+				suite.Require().Equal(expNonce, acc.GetSequence())
+			}
+
+			// the gas wanted of the tx is accounted only once for the block
+			gasWanted := suite.GetNetwork().App.FeeMarketKeeper.GetTransientGasWanted(newCtx)
+			suite.Require().Equal(tx.GetGas(), gasWanted)
+		})
+	}
+	suite.WithFeemarketEnabled(false)
+}
@@ -76,8 +76,9 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// NOTE: the protocol does not support multiple EVM messages currently so
-	// this loop will complete after the first message.
+	// A single Cosmos tx can batch several MsgEthereumTx from one or more
+	// senders. Fees, nonces and gas are accounted for each message, while the
+	// tx-wide checks run once after the loop.
 	for i, msg := range tx.GetMsgs() {
 		ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
 		if err != nil {
@@ -233,16 +234,19 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
-		// 10. gas wanted
-		if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
-			return ctx, err
-		}
-
-		// 11. emit events
+		// 10. emit events
 		txIdx := uint64(i) //nolint:gosec // G115
 		EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
 	}
 
+	// 11. gas wanted
+	//
+	// NOTE: the tx gas is the sum of the gas limits of all messages, so it is
+	// added to the block gas wanted only once.
+	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
+		return ctx, err
+	}
+
 	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
 		return ctx, err
 	}
@@ -8,6 +8,9 @@ import (
 	"maps"
 
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -16,15 +19,18 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
+	authzprecompile "github.com/evmos/os/precompiles/authz"
 	bankprecompile "github.com/evmos/os/precompiles/bank"
 	"github.com/evmos/os/precompiles/bech32"
 	distprecompile "github.com/evmos/os/precompiles/distribution"
 	evidenceprecompile "github.com/evmos/os/precompiles/evidence"
+	feegrantprecompile "github.com/evmos/os/precompiles/feegrant"
 	govprecompile "github.com/evmos/os/precompiles/gov"
 	ics20precompile "github.com/evmos/os/precompiles/ics20"
 	"github.com/evmos/os/precompiles/p256"
 	slashingprecompile "github.com/evmos/os/precompiles/slashing"
 	stakingprecompile "github.com/evmos/os/precompiles/staking"
+	vestingprecompile "github.com/evmos/os/precompiles/vesting"
 	erc20Keeper "github.com/evmos/os/x/erc20/keeper"
 	"github.com/evmos/os/x/evm/core/vm"
 	evmkeeper "github.com/evmos/os/x/evm/keeper"
@@ -48,6 +54,9 @@ func NewAvailableStaticPrecompiles(
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
 	evidenceKeeper evidencekeeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	cdc codec.Codec,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -91,7 +100,7 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
-	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper)
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, cdc, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
 	}
@@ -106,6 +115,21 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate evidence precompile: %w", err))
 	}
 
+	vestingPrecompile, err := vestingprecompile.NewPrecompile(accountKeeper, bankKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate vesting precompile: %w", err))
+	}
+
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, bankKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -118,6 +142,9 @@ func NewAvailableStaticPrecompiles(
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[evidencePrecompile.Address()] = evidencePrecompile
+	precompiles[vestingPrecompile.Address()] = vestingPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
 
 	return precompiles
 }
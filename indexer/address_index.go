@@ -0,0 +1,289 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package indexer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	rpctypes "github.com/evmos/os/rpc/types"
+	evmostypes "github.com/evmos/os/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+const (
+	KeyPrefixAddressTx              = 5
+	KeyPrefixLogTopicTx             = 6
+	KeyPrefixAddressJournal         = 7
+	KeyPrefixAddressIndexFirstBlock = 8
+
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 4
+	// LogTopicTxKeyLength is the length of log-topic-tx key
+	LogTopicTxKeyLength = 1 + common.AddressLength + common.HashLength + 8 + 4
+)
+
+var _ evmostypes.AddressIndexer = &KVIndexer{}
+
+// WithAddressIndex enables the secondary indexes of the eth txs by sender,
+// recipient and created contract address, and by log emitter address and
+// first topic. Only the given number of recent blocks is kept in the secondary
+// indexes, unless the retention is 0.
+func (kv *KVIndexer) WithAddressIndex(retention uint64) *KVIndexer {
+	kv.addressIndex = true
+	kv.addressIndexRetention = retention
+	return kv
+}
+
+// FirstAddressIndexedBlock returns the first block number indexed by the
+// secondary indexes, returns -1 if none is. The blocks indexed before the
+// secondary indexes were enabled are not in them.
+func (kv *KVIndexer) FirstAddressIndexedBlock() (int64, error) {
+	if !kv.addressIndex {
+		return 0, errors.New("address index is not enabled")
+	}
+
+	bz, err := kv.db.Get(AddressIndexFirstBlockKey())
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstAddressIndexedBlock")
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil //nolint:gosec // G115 // block number won't exceed int64
+}
+
+// GetByAddress returns the hashes of the eth txs sent from, sent to or
+// creating the address within the given block range, in execution order.
+func (kv *KVIndexer) GetByAddress(addr common.Address, fromBlock, toBlock int64) ([]common.Hash, error) {
+	if !kv.addressIndex {
+		return nil, errors.New("address index is not enabled")
+	}
+
+	prefix := append([]byte{KeyPrefixAddressTx}, addr.Bytes()...)
+	hashes, err := kv.iterateTxHashes(prefix, fromBlock, toBlock)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByAddress %s", addr.Hex())
+	}
+	return hashes, nil
+}
+
+// GetByLogTopic returns the hashes of the eth txs with logs emitted by the
+// address with the given first topic within the given block range, in
+// execution order.
+func (kv *KVIndexer) GetByLogTopic(addr common.Address, topic0 common.Hash, fromBlock, toBlock int64) ([]common.Hash, error) {
+	if !kv.addressIndex {
+		return nil, errors.New("address index is not enabled")
+	}
+
+	prefix := append(append([]byte{KeyPrefixLogTopicTx}, addr.Bytes()...), topic0.Bytes()...)
+	hashes, err := kv.iterateTxHashes(prefix, fromBlock, toBlock)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByLogTopic %s %s", addr.Hex(), topic0.Hex())
+	}
+	return hashes, nil
+}
+
+// iterateTxHashes returns the tx hashes stored under the given prefix, followed
+// by the block number, within the given block range.
+func (kv *KVIndexer) iterateTxHashes(prefix []byte, fromBlock, toBlock int64) ([]common.Hash, error) {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(fromBlock))...) //nolint:gosec // G115 // block number won't exceed uint64
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(toBlock+1))...)   //nolint:gosec // G115 // block number won't exceed uint64
+
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	hashes := make([]common.Hash, 0)
+	for ; it.Valid(); it.Next() {
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+	}
+	return hashes, it.Error()
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, eth tx index) -> tx hash`
+func AddressTxKey(addr common.Address, blockNumber int64, ethTxIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))             //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := binary.BigEndian.AppendUint32(nil, uint32(ethTxIndex)) //nolint:gosec // G115 // index is never negative
+	return append(append(append([]byte{KeyPrefixAddressTx}, addr.Bytes()...), bz1...), bz2...)
+}
+
+// LogTopicTxKey returns the key for db entry: `(address, topic, block number, eth tx index) -> tx hash`
+func LogTopicTxKey(addr common.Address, topic0 common.Hash, blockNumber int64, ethTxIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))             //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := binary.BigEndian.AppendUint32(nil, uint32(ethTxIndex)) //nolint:gosec // G115 // index is never negative
+	key := append(append([]byte{KeyPrefixLogTopicTx}, addr.Bytes()...), topic0.Bytes()...)
+	return append(append(key, bz1...), bz2...)
+}
+
+// AddressJournalKey returns the key for db entry: `block number -> address index keys`
+func AddressJournalKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixAddressJournal}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// AddressIndexFirstBlockKey returns the key for db entry: `first block number of the address indexes`
+func AddressIndexFirstBlockKey() []byte {
+	return []byte{KeyPrefixAddressIndexFirstBlock}
+}
+
+// saveAddressIndexes indexes the eth tx into the kv db batch by sender,
+// recipient, created contract and log emitter addresses. It returns the keys
+// written to the batch.
+func saveAddressIndexes(
+	batch dbm.Batch,
+	ethMsg *evmtypes.MsgEthereumTx,
+	txHash common.Hash,
+	txResult *evmostypes.TxResult,
+	events []abci.Event,
+) ([][]byte, error) {
+	tx := ethMsg.AsTransaction()
+	if tx == nil {
+		return nil, fmt.Errorf("failed to unpack tx data of %s", txHash.Hex())
+	}
+
+	from, err := recoverSender(tx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to recover sender of %s", txHash.Hex())
+	}
+
+	addrs := make([]common.Address, 0, 3)
+	addrs = append(addrs, from)
+	if tx.To() == nil && !txResult.Failed {
+		addrs = append(addrs, crypto.CreateAddress(from, tx.Nonce()))
+	}
+	if tx.To() != nil {
+		addrs = append(addrs, *tx.To())
+	}
+
+	keys := make([][]byte, 0, len(addrs))
+	for _, addr := range addrs {
+		keys = append(keys, AddressTxKey(addr, txResult.Height, txResult.EthTxIndex))
+	}
+
+	if !txResult.Failed {
+		logs, err := rpctypes.TxLogsFromEvents(events, int(txResult.MsgIndex))
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range logs {
+			var topic0 common.Hash
+			if len(log.Topics) > 0 {
+				topic0 = log.Topics[0]
+			}
+			keys = append(keys, LogTopicTxKey(log.Address, topic0, txResult.Height, txResult.EthTxIndex))
+		}
+	}
+
+	for _, key := range keys {
+		if err := batch.Set(key, txHash.Bytes()); err != nil {
+			return nil, errorsmod.Wrap(err, "set address index key")
+		}
+	}
+	return keys, nil
+}
+
+// saveAddressIndexJournal stores the keys of the secondary indexes written for
+// the block, and deletes the ones of the blocks out of the retention window.
+func (kv *KVIndexer) saveAddressIndexJournal(batch dbm.Batch, height int64, keys [][]byte) error {
+	if len(keys) > 0 {
+		journal := make([]byte, 0, len(keys)*LogTopicTxKeyLength)
+		for _, key := range keys {
+			journal = append(journal, key...)
+		}
+		if err := batch.Set(AddressJournalKey(height), journal); err != nil {
+			return errorsmod.Wrap(err, "set address journal key")
+		}
+	}
+
+	retention := int64(kv.addressIndexRetention) //nolint:gosec // G115 // retention won't exceed int64
+	if retention == 0 || height <= retention {
+		return nil
+	}
+
+	it, err := kv.db.Iterator(AddressJournalKey(0), AddressJournalKey(height-retention+1))
+	if err != nil {
+		return errorsmod.Wrap(err, "prune address index")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		keys, err := parseAddressJournal(it.Value())
+		if err != nil {
+			return errorsmod.Wrap(err, "prune address index")
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return errorsmod.Wrap(err, "delete address index key")
+			}
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete address journal key")
+		}
+	}
+	return it.Error()
+}
+
+// saveAddressIndexFirstBlock stores the block as the first one indexed by the
+// secondary indexes when they are enabled on a new or existing db. Indexing the
+// block right before the first one, as done by the backward indexer command,
+// extends the indexed range.
+func (kv *KVIndexer) saveAddressIndexFirstBlock(batch dbm.Batch, height int64) error {
+	first, err := kv.FirstAddressIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if first != -1 && height != first-1 {
+		return nil
+	}
+	if err := batch.Set(AddressIndexFirstBlockKey(), sdk.Uint64ToBigEndian(uint64(height))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+		return errorsmod.Wrap(err, "set address index first block key")
+	}
+	return nil
+}
+
+// deleteAddressIndexFirstBlock deletes the first block indexed by the secondary
+// indexes when they are disabled, so that enabling them again starts a new
+// range instead of serving the blocks indexed in between.
+func (kv *KVIndexer) deleteAddressIndexFirstBlock(batch dbm.Batch) error {
+	found, err := kv.db.Has(AddressIndexFirstBlockKey())
+	if err != nil || !found {
+		return err
+	}
+	if err := batch.Delete(AddressIndexFirstBlockKey()); err != nil {
+		return errorsmod.Wrap(err, "delete address index first block key")
+	}
+	return nil
+}
+
+// parseAddressJournal splits the journal of a block into the keys of the
+// secondary indexes, whose length is given by their prefix.
+func parseAddressJournal(journal []byte) ([][]byte, error) {
+	var keys [][]byte
+	for len(journal) > 0 {
+		var length int
+		switch journal[0] {
+		case KeyPrefixAddressTx:
+			length = AddressTxKeyLength
+		case KeyPrefixLogTopicTx:
+			length = LogTopicTxKeyLength
+		default:
+			return nil, fmt.Errorf("unknown address index key prefix %d", journal[0])
+		}
+		if len(journal) < length {
+			return nil, fmt.Errorf("wrong address index key length, expect: %d, got: %d", length, len(journal))
+		}
+		keys = append(keys, journal[:length])
+		journal = journal[length:]
+	}
+	return keys, nil
+}
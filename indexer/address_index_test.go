@@ -0,0 +1,169 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/os/crypto/ethsecp256k1"
+	"github.com/evmos/os/indexer"
+	"github.com/evmos/os/testutil/constants"
+	"github.com/evmos/os/testutil/integration/os/network"
+	utiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVIndexerAddressIndex(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	other := common.BigToAddress(big.NewInt(2))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	topic := common.HexToHash("0x01")
+	txLog, err := json.Marshal(types.NewLogFromEth(&ethtypes.Log{
+		Address: to,
+		Topics:  []common.Hash{topic},
+		TxHash:  txHash,
+	}))
+	require.NoError(t, err)
+
+	results := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: types.AttributeKeyTxLog, Value: string(txLog)},
+				}},
+			},
+		},
+	}
+
+	indexBlocks := func(idxer *indexer.KVIndexer, heights ...int64) {
+		for _, height := range heights {
+			block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+			require.NoError(t, idxer.IndexBlock(block, results))
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		indexBlocks(idxer, 1)
+
+		_, err := idxer.GetByAddress(from, 1, 1)
+		require.ErrorContains(t, err, "address index is not enabled")
+	})
+
+	t.Run("sender and recipient", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx).WithAddressIndex(0)
+		indexBlocks(idxer, 1, 2, 3)
+
+		for _, addr := range []common.Address{from, to} {
+			hashes, err := idxer.GetByAddress(addr, 1, 3)
+			require.NoError(t, err)
+			require.Equal(t, []common.Hash{txHash, txHash, txHash}, hashes)
+
+			hashes, err = idxer.GetByAddress(addr, 2, 2)
+			require.NoError(t, err)
+			require.Equal(t, []common.Hash{txHash}, hashes)
+		}
+
+		hashes, err := idxer.GetByAddress(other, 1, 3)
+		require.NoError(t, err)
+		require.Empty(t, hashes)
+
+		hashes, err = idxer.GetByLogTopic(to, topic, 1, 3)
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{txHash, txHash, txHash}, hashes)
+
+		hashes, err = idxer.GetByLogTopic(from, topic, 1, 3)
+		require.NoError(t, err)
+		require.Empty(t, hashes)
+	})
+
+	t.Run("enabled on an existing db", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+		indexBlocks(idxer, 3, 4)
+
+		idxer = indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx).WithAddressIndex(0)
+		first, err := idxer.FirstAddressIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+
+		indexBlocks(idxer, 5, 6)
+		first, err = idxer.FirstAddressIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), first)
+
+		// indexing the previous blocks backward extends the indexed range
+		indexBlocks(idxer, 4, 3)
+		first, err = idxer.FirstAddressIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), first)
+
+		// indexing an older block does not leave a gap in the indexed range
+		indexBlocks(idxer, 1)
+		first, err = idxer.FirstAddressIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), first)
+
+		// disabling the address index starts a new range on the next enabling
+		indexBlocks(indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx), 7)
+		indexBlocks(idxer, 8)
+		first, err = idxer.FirstAddressIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(8), first)
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx).WithAddressIndex(2)
+		indexBlocks(idxer, 1, 2, 3, 4)
+
+		hashes, err := idxer.GetByAddress(from, 1, 4)
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{txHash, txHash}, hashes)
+
+		// the primary index is not pruned
+		res, err := idxer.GetByBlockAndIndex(1, 0)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	evmostypes "github.com/evmos/os/types"
+
+	// register the postgres driver of database/sql
+	_ "github.com/lib/pq"
+)
+
+const (
+	// KVBackend stores the eth txs in a local KV db, next to the node data.
+	KVBackend = "kv"
+	// PostgresBackend stores the eth txs in normalized tables of a Postgres db.
+	PostgresBackend = "postgres"
+)
+
+// BackendOptions defines the parameters used to create an EVMTxIndexer backend.
+type BackendOptions struct {
+	// HomeDir is the home directory of the node
+	HomeDir string
+	// DBBackend is the db backend of the node, used by the local db backends
+	DBBackend dbm.BackendType
+	// DSN is the data source name of the database, used by the SQL backends
+	DSN string
+	// AddressIndex enables the indexes of the eth txs by address
+	AddressIndex bool
+	// AddressIndexRetention is the number of recent blocks kept in the address indexes, 0 keeps all of them
+	AddressIndexRetention uint64
+
+	Logger    log.Logger
+	ClientCtx client.Context
+}
+
+// BackendCreator creates an EVMTxIndexer backend.
+type BackendCreator = func(opts BackendOptions) (evmostypes.EVMTxIndexer, error)
+
+// backendCreators defines the EVMTxIndexer backends.
+var backendCreators map[string]BackendCreator
+
+func init() {
+	backendCreators = map[string]BackendCreator{
+		KVBackend: func(opts BackendOptions) (evmostypes.EVMTxIndexer, error) {
+			db, err := OpenKVIndexerDB(opts.HomeDir, opts.DBBackend)
+			if err != nil {
+				return nil, err
+			}
+			idxer := NewKVIndexer(db, opts.Logger, opts.ClientCtx)
+			if opts.AddressIndex {
+				idxer = idxer.WithAddressIndex(opts.AddressIndexRetention)
+			}
+			return idxer, nil
+		},
+		PostgresBackend: func(opts BackendOptions) (evmostypes.EVMTxIndexer, error) {
+			if opts.DSN == "" {
+				return nil, errors.New("postgres indexer backend requires a data source name")
+			}
+			// the address queries use the tables of the txs and logs, which are never pruned
+			if opts.AddressIndex && opts.AddressIndexRetention > 0 {
+				return nil, errors.New("postgres indexer backend doesn't support an address index retention, set it to 0")
+			}
+			db, err := sql.Open("postgres", opts.DSN)
+			if err != nil {
+				return nil, err
+			}
+			idxer, err := NewSQLIndexer(db, DialectPostgres, opts.Logger, opts.ClientCtx)
+			if err != nil {
+				return nil, err
+			}
+			if opts.AddressIndex {
+				idxer = idxer.WithAddressIndex()
+			}
+			return idxer, nil
+		},
+	}
+}
+
+// NewEVMTxIndexer creates the EVMTxIndexer of the given backend.
+func NewEVMTxIndexer(backend string, opts BackendOptions) (evmostypes.EVMTxIndexer, error) {
+	creator, ok := backendCreators[backend]
+	if !ok {
+		return nil, fmt.Errorf("unknown indexer backend %s, expect one of: %v", backend, Backends())
+	}
+	return creator(opts)
+}
+
+// Backends returns the names of the registered EVMTxIndexer backends.
+func Backends() []string {
+	backends := make([]string, 0, len(backendCreators))
+	for backend := range backendCreators {
+		backends = append(backends, backend)
+	}
+	sort.Strings(backends)
+	return backends
+}
+
+// RegisterBackend registers a new EVMTxIndexer backend with its creator.
+// This function fails if the backend is already registered.
+func RegisterBackend(backend string, creator BackendCreator) error {
+	if _, ok := backendCreators[backend]; ok {
+		return fmt.Errorf("duplicated indexer backend %s", backend)
+	}
+	backendCreators[backend] = creator
+	return nil
+}
+
+// OpenKVIndexerDB opens the db of the kv backend, using the same db backend as the main app
+func OpenKVIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmindexer", backendType, dataDir)
+}
@@ -0,0 +1,131 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package indexer
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	evmostypes "github.com/evmos/os/types"
+)
+
+const (
+	KeyPrefixBloomBits = 3
+	KeyBloomSections   = 4
+
+	// BloomBitsKeyLength is the length of the bloom-bits key
+	BloomBitsKeyLength = 1 + 2 + 8
+)
+
+// BloomBitsBlocks is the number of blocks of a bloom bits section, matching
+// the section size used by geth.
+const BloomBitsBlocks = params.BloomBitsBlocks
+
+var _ evmostypes.BloomIndexer = &KVIndexer{}
+
+// BloomSectionSize returns the number of blocks in a bloom bits section.
+func (kv *KVIndexer) BloomSectionSize() uint64 {
+	return BloomBitsBlocks
+}
+
+// BloomSections returns the number of fully indexed bloom bits sections.
+func (kv *KVIndexer) BloomSections() (uint64, error) {
+	bz, err := kv.db.Get([]byte{KeyBloomSections})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "BloomSections")
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// IndexBloomSection rotates the blooms of all the blocks in a section into
+// bloom bits and stores the compressed bit vectors in a single batch, together
+// with the new number of indexed sections.
+func (kv *KVIndexer) IndexBloomSection(section uint64, blooms []ethtypes.Bloom) error {
+	sections, err := kv.BloomSections()
+	if err != nil {
+		return err
+	}
+	if section != sections {
+		return fmt.Errorf("IndexBloomSection %d, expected section %d", section, sections)
+	}
+	if uint64(len(blooms)) != BloomBitsBlocks {
+		return fmt.Errorf("IndexBloomSection %d, expected %d blooms, got %d", section, BloomBitsBlocks, len(blooms))
+	}
+
+	gen, err := bloombits.NewGenerator(uint(BloomBitsBlocks))
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBloomSection %d", section)
+	}
+	for i, bloom := range blooms {
+		if err := gen.AddBloom(uint(i), bloom); err != nil { //nolint:gosec // G115 // index is lower than the section size
+			return errorsmod.Wrapf(err, "IndexBloomSection %d, add bloom %d", section, i)
+		}
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := gen.Bitset(bit)
+		if err != nil {
+			return errorsmod.Wrapf(err, "IndexBloomSection %d, bitset %d", section, bit)
+		}
+		// an empty bit vector compresses to nothing and is not stored
+		compressed := bitutil.CompressBytes(bits)
+		if len(compressed) == 0 {
+			continue
+		}
+		if err := batch.Set(BloomBitsKey(bit, section), compressed); err != nil {
+			return errorsmod.Wrapf(err, "IndexBloomSection %d, set bloom-bits key", section)
+		}
+	}
+	if err := batch.Set([]byte{KeyBloomSections}, sdk.Uint64ToBigEndian(section+1)); err != nil {
+		return errorsmod.Wrapf(err, "IndexBloomSection %d, set sections key", section)
+	}
+
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBloomSection %d, write batch", section)
+	}
+	return nil
+}
+
+// GetBloomBits returns the decompressed bit vector of a bloom bit in a section.
+// The bit of each block is set starting from the most significant bit of the
+// first byte.
+func (kv *KVIndexer) GetBloomBits(bit uint, section uint64) ([]byte, error) {
+	bz, err := kv.db.Get(BloomBitsKey(bit, section))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBloomBits %d %d", bit, section)
+	}
+	if len(bz) == 0 {
+		sections, err := kv.BloomSections()
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetBloomBits %d %d", bit, section)
+		}
+		if section >= sections {
+			return nil, fmt.Errorf("bloom bits not found, bit: %d, section: %d", bit, section)
+		}
+		// the bit is not set in any block of the indexed section
+		return make([]byte, BloomBitsBlocks/8), nil
+	}
+	return bitutil.DecompressBytes(bz, int(BloomBitsBlocks/8))
+}
+
+// BloomBitsKey returns the key for db entry: `(bit, section) -> compressed bit vector`
+func BloomBitsKey(bit uint, section uint64) []byte {
+	key := make([]byte, BloomBitsKeyLength)
+	key[0] = KeyPrefixBloomBits
+	key[1] = byte(bit >> 8)
+	key[2] = byte(bit)
+	copy(key[3:], sdk.Uint64ToBigEndian(section))
+	return key
+}
@@ -0,0 +1,46 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/os/indexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVIndexerBloomBits(t *testing.T) {
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+
+	sections, err := idxer.BloomSections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sections)
+
+	// a single bit set in the first bloom byte is the last bloom bit
+	blooms := make([]ethtypes.Bloom, indexer.BloomBitsBlocks)
+	blooms[1][0] = 0x80
+
+	// sections have to be indexed in order
+	require.ErrorContains(t, idxer.IndexBloomSection(1, blooms), "expected section 0")
+	// the section has to be complete
+	require.ErrorContains(t, idxer.IndexBloomSection(0, blooms[1:]), "expected 4096 blooms")
+
+	require.NoError(t, idxer.IndexBloomSection(0, blooms))
+	sections, err = idxer.BloomSections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sections)
+
+	vector, err := idxer.GetBloomBits(ethtypes.BloomBitLength-1, 0)
+	require.NoError(t, err)
+	require.Len(t, vector, int(indexer.BloomBitsBlocks/8))
+	require.Equal(t, byte(0x40), vector[0])
+
+	vector, err = idxer.GetBloomBits(0, 0)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, indexer.BloomBitsBlocks/8), vector)
+
+	_, err = idxer.GetBloomBits(0, 1)
+	require.ErrorContains(t, err, "bloom bits not found")
+}
@@ -14,11 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
-	rpctypes "github.com/evmos/os/rpc/types"
 	evmostypes "github.com/evmos/os/types"
-	evmtypes "github.com/evmos/os/x/evm/types"
 )
 
 const (
@@ -36,85 +33,54 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// addressIndex enables the secondary indexes by address
+	addressIndex bool
+	// addressIndexRetention is the number of recent blocks kept in the
+	// secondary indexes, 0 keeps all of them
+	addressIndexRetention uint64
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
-// - Iterates over all of the Txs in Block
-// - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
-// - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Parses the eth txs of the block and their results from cosmos-sdk events
+// - Stores a indexer.TxResult for every eth tx
+// - Stores the secondary indexes by address of every eth tx, if enabled
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Header.Height
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
-		}
+	// keys of the secondary indexes written for the block
+	var addressKeys [][]byte
 
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
+	for _, ethTx := range parseBlockEthTxs(kv.clientCtx, kv.logger, block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, ethTx.hash, &ethTx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
 
-		if !isEthTx(tx) {
-			continue
-		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := common.HexToHash(ethMsg.Hash)
-
-			txResult := evmostypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
-				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+		if kv.addressIndex {
+			keys, err := saveAddressIndexes(batch, ethTx.msg, ethTx.hash, &ethTx.result, ethTx.events)
+			if err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			addressKeys = append(addressKeys, keys...)
+		}
+	}
+	if kv.addressIndex {
+		if err := kv.saveAddressIndexJournal(batch, height, addressKeys); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+		if err := kv.saveAddressIndexFirstBlock(batch, height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
+	} else if err := kv.deleteAddressIndexFirstBlock(batch); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
 	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
@@ -198,19 +164,6 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
-// isEthTx check if the tx is an eth tx
-func isEthTx(tx sdk.Tx) bool {
-	extTx, ok := tx.(authante.HasExtensionOptionsTx)
-	if !ok {
-		return false
-	}
-	opts := extTx.GetExtensionOptions()
-	if len(opts) != 1 || opts[0].GetTypeUrl() != "/os.evm.v1.ExtensionOptionsEthereumTx" {
-		return false
-	}
-	return true
-}
-
 // saveTxResult index the txResult into the kv db batch
 func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *evmostypes.TxResult) error {
 	bz := codec.MustMarshal(txResult)
@@ -0,0 +1,430 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	rpctypes "github.com/evmos/os/rpc/types"
+	evmostypes "github.com/evmos/os/types"
+)
+
+// SQLDialect defines the SQL dialect of the database used by the SQLIndexer.
+type SQLDialect int
+
+const (
+	// DialectPostgres uses numbered placeholders, e.g. `$1`
+	DialectPostgres SQLDialect = iota
+	// DialectSQLite uses question mark placeholders, e.g. `?`
+	DialectSQLite
+)
+
+// transferEventTopic is the topic of the `Transfer(address,address,uint256)`
+// event of the ERC-20 and ERC-721 tokens.
+var transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// sqlSchema defines the normalized tables of the SQLIndexer. The addresses and
+// hashes are stored as lower case hex strings, and the big integers as decimal
+// strings, so that the tables can be queried the same way on every database.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS evm_blocks (
+		height BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		block_time BIGINT NOT NULL,
+		eth_tx_count INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS evm_txs (
+		hash TEXT PRIMARY KEY,
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		msg_index INTEGER NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		failed BOOLEAN NOT NULL,
+		gas_used BIGINT NOT NULL,
+		cumulative_gas_used BIGINT NOT NULL,
+		tx_type INTEGER NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address TEXT,
+		contract_address TEXT,
+		nonce BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		UNIQUE (height, eth_tx_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS evm_txs_from_address ON evm_txs (from_address, height)`,
+	`CREATE INDEX IF NOT EXISTS evm_txs_to_address ON evm_txs (to_address, height)`,
+	`CREATE INDEX IF NOT EXISTS evm_txs_contract_address ON evm_txs (contract_address, height)`,
+	`CREATE TABLE IF NOT EXISTS evm_logs (
+		height BIGINT NOT NULL,
+		log_index INTEGER NOT NULL,
+		tx_hash TEXT NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		address TEXT NOT NULL,
+		topic0 TEXT,
+		topic1 TEXT,
+		topic2 TEXT,
+		topic3 TEXT,
+		data TEXT NOT NULL,
+		PRIMARY KEY (height, log_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_address_topic0 ON evm_logs (address, topic0, height)`,
+	`CREATE TABLE IF NOT EXISTS evm_token_transfers (
+		height BIGINT NOT NULL,
+		log_index INTEGER NOT NULL,
+		tx_hash TEXT NOT NULL,
+		token_address TEXT NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (height, log_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS evm_token_transfers_from_address ON evm_token_transfers (from_address, height)`,
+	`CREATE INDEX IF NOT EXISTS evm_token_transfers_to_address ON evm_token_transfers (to_address, height)`,
+}
+
+var _ evmostypes.EVMTxIndexer = &SQLIndexer{}
+
+// SQLIndexer implements a eth tx indexer on a SQL database, storing the
+// blocks, txs, logs and token transfers in normalized tables.
+type SQLIndexer struct {
+	db        *sql.DB
+	dialect   SQLDialect
+	logger    log.Logger
+	clientCtx client.Context
+
+	// addressIndex enables the queries by address
+	addressIndex bool
+}
+
+// NewSQLIndexer creates the SQLIndexer and the tables of the database, if they
+// don't exist yet.
+func NewSQLIndexer(db *sql.DB, dialect SQLDialect, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	for _, stmt := range sqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to create sql indexer schema")
+		}
+	}
+	return &SQLIndexer{db: db, dialect: dialect, logger: logger, clientCtx: clientCtx}, nil
+}
+
+// WithAddressIndex enables the queries of the eth txs by sender, recipient and
+// created contract address, and by log emitter address and first topic. The
+// queries use the tables of the txs and logs, so the address index cannot be
+// pruned separately as done by the KVIndexer.
+func (si *SQLIndexer) WithAddressIndex() *SQLIndexer {
+	si.addressIndex = true
+	return si
+}
+
+// IndexBlock index all the eth txs of a block, along with their logs and token
+// transfers, in a single database transaction. Indexing a block again replaces
+// the rows stored for it.
+func (si *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Header.Height
+	ethTxs := parseBlockEthTxs(si.clientCtx, si.logger, block, txResults)
+
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op once committed
+
+	for _, table := range []string{"evm_token_transfers", "evm_logs", "evm_txs", "evm_blocks"} {
+		if _, err := dbTx.Exec(si.rebind("DELETE FROM "+table+" WHERE height = ?"), height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete %s", height, table)
+		}
+	}
+
+	if _, err := dbTx.Exec(
+		si.rebind("INSERT INTO evm_blocks (height, hash, block_time, eth_tx_count) VALUES (?, ?, ?, ?)"),
+		height, hexutil.Encode(block.Hash()), block.Time.Unix(), len(ethTxs),
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+
+	for _, ethTx := range ethTxs {
+		if err := si.saveEthTx(dbTx, ethTx); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", height)
+	}
+	return nil
+}
+
+// saveEthTx inserts the eth tx, its logs and its token transfers.
+func (si *SQLIndexer) saveEthTx(dbTx *sql.Tx, ethTx blockEthTx) error {
+	tx := ethTx.msg.AsTransaction()
+	if tx == nil {
+		return fmt.Errorf("failed to unpack tx data of %s", ethTx.hash.Hex())
+	}
+	from, err := recoverSender(tx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to recover sender of %s", ethTx.hash.Hex())
+	}
+
+	var to, contract sql.NullString
+	if tx.To() != nil {
+		to = sql.NullString{String: hexAddress(*tx.To()), Valid: true}
+	} else if !ethTx.result.Failed {
+		contract = sql.NullString{String: hexAddress(crypto.CreateAddress(from, tx.Nonce())), Valid: true}
+	}
+
+	res := ethTx.result
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO evm_txs (
+			hash, height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used,
+			tx_type, from_address, to_address, contract_address, nonce, value, gas_limit
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		ethTx.hash.Hex(), res.Height, res.TxIndex, res.MsgIndex, res.EthTxIndex, res.Failed, res.GasUsed, res.CumulativeGasUsed,
+		tx.Type(), hexAddress(from), to, contract, tx.Nonce(), tx.Value().String(), tx.Gas(),
+	); err != nil {
+		return errorsmod.Wrapf(err, "insert tx %s", ethTx.hash.Hex())
+	}
+
+	if res.Failed {
+		return nil
+	}
+
+	logs, err := rpctypes.TxLogsFromEvents(ethTx.events, int(res.MsgIndex))
+	if err != nil {
+		return err
+	}
+	for _, log := range logs {
+		topics := make([]sql.NullString, 4)
+		for i, topic := range log.Topics {
+			if i < len(topics) {
+				topics[i] = sql.NullString{String: topic.Hex(), Valid: true}
+			}
+		}
+		if _, err := dbTx.Exec(
+			si.rebind(`INSERT INTO evm_logs (
+				height, log_index, tx_hash, eth_tx_index, address, topic0, topic1, topic2, topic3, data
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+			res.Height, log.Index, ethTx.hash.Hex(), res.EthTxIndex, hexAddress(log.Address),
+			topics[0], topics[1], topics[2], topics[3], hexutil.Encode(log.Data),
+		); err != nil {
+			return errorsmod.Wrapf(err, "insert log %d", log.Index)
+		}
+
+		if err := si.saveTokenTransfer(dbTx, res.Height, ethTx.hash, log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveTokenTransfer inserts the token transfer of the log, if it is a
+// `Transfer` event of an ERC-20 or ERC-721 token. The value is the amount of
+// ERC-20 tokens or the id of the ERC-721 token.
+func (si *SQLIndexer) saveTokenTransfer(dbTx *sql.Tx, height int64, txHash common.Hash, log *ethtypes.Log) error {
+	if len(log.Topics) == 0 || log.Topics[0] != transferEventTopic {
+		return nil
+	}
+
+	var value common.Hash
+	switch {
+	case len(log.Topics) == 3 && len(log.Data) == common.HashLength:
+		// ERC-20: the amount is not indexed
+		value = common.BytesToHash(log.Data)
+	case len(log.Topics) == 4 && len(log.Data) == 0:
+		// ERC-721: the token id is indexed
+		value = log.Topics[3]
+	default:
+		return nil
+	}
+
+	if _, err := dbTx.Exec(
+		si.rebind(`INSERT INTO evm_token_transfers (
+			height, log_index, tx_hash, token_address, from_address, to_address, value
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		height, log.Index, txHash.Hex(), hexAddress(log.Address),
+		hexAddress(common.BytesToAddress(log.Topics[1].Bytes())),
+		hexAddress(common.BytesToAddress(log.Topics[2].Bytes())),
+		value.Big().String(),
+	); err != nil {
+		return errorsmod.Wrapf(err, "insert token transfer %d", log.Index)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.queryHeight("SELECT MAX(height) FROM evm_blocks")
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.queryHeight("SELECT MIN(height) FROM evm_blocks")
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*evmostypes.TxResult, error) {
+	res, err := si.queryTxResult("WHERE hash = ?", hash.Hex())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*evmostypes.TxResult, error) {
+	res, err := si.queryTxResult("WHERE height = ? AND eth_tx_index = ?", blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+// FirstAddressIndexedBlock returns the first block number served by the
+// queries by address, returns -1 if db is empty. The queries use the tables of
+// the txs and logs, which are filled for every indexed block.
+func (si *SQLIndexer) FirstAddressIndexedBlock() (int64, error) {
+	if !si.addressIndex {
+		return 0, errors.New("address index is not enabled")
+	}
+	return si.FirstIndexedBlock()
+}
+
+// GetByAddress returns the hashes of the eth txs sent from, sent to or
+// creating the address within the given block range, in execution order.
+func (si *SQLIndexer) GetByAddress(addr common.Address, fromBlock, toBlock int64) ([]common.Hash, error) {
+	if !si.addressIndex {
+		return nil, errors.New("address index is not enabled")
+	}
+
+	address := hexAddress(addr)
+	hashes, err := si.queryTxHashes(
+		`SELECT hash FROM evm_txs
+		WHERE (from_address = ? OR to_address = ? OR contract_address = ?) AND height >= ? AND height <= ?
+		ORDER BY height, eth_tx_index`,
+		address, address, address, fromBlock, toBlock,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByAddress %s", addr.Hex())
+	}
+	return hashes, nil
+}
+
+// GetByLogTopic returns the hashes of the eth txs with logs emitted by the
+// address with the given first topic within the given block range, in
+// execution order.
+func (si *SQLIndexer) GetByLogTopic(addr common.Address, topic0 common.Hash, fromBlock, toBlock int64) ([]common.Hash, error) {
+	if !si.addressIndex {
+		return nil, errors.New("address index is not enabled")
+	}
+
+	hashes, err := si.queryTxHashes(
+		`SELECT DISTINCT tx_hash, height, eth_tx_index FROM evm_logs
+		WHERE address = ? AND topic0 = ? AND height >= ? AND height <= ?
+		ORDER BY height, eth_tx_index`,
+		hexAddress(addr), topic0.Hex(), fromBlock, toBlock,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByLogTopic %s %s", addr.Hex(), topic0.Hex())
+	}
+	return hashes, nil
+}
+
+// queryHeight returns the block number selected by the query, returns -1 if db is empty
+func (si *SQLIndexer) queryHeight(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(query).Scan(&height); err != nil {
+		return 0, err
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// queryTxResult returns the result of the eth tx matching the where clause.
+func (si *SQLIndexer) queryTxResult(where string, args ...interface{}) (*evmostypes.TxResult, error) {
+	var res evmostypes.TxResult
+	err := si.db.QueryRow(
+		si.rebind("SELECT height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used FROM evm_txs "+where),
+		args...,
+	).Scan(&res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &res.Failed, &res.GasUsed, &res.CumulativeGasUsed)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// queryTxHashes returns the tx hashes of the first column selected by the query.
+func (si *SQLIndexer) queryTxHashes(query string, args ...interface{}) ([]common.Hash, error) {
+	rows, err := si.db.Query(si.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]common.Hash, 0)
+	for rows.Next() {
+		var hash string
+		dest := make([]interface{}, len(columns))
+		dest[0] = &hash
+		for i := 1; i < len(dest); i++ {
+			dest[i] = new(interface{})
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, common.HexToHash(hash))
+	}
+	return hashes, rows.Err()
+}
+
+// rebind replaces the `?` placeholders of the query with the ones of the
+// dialect of the database.
+func (si *SQLIndexer) rebind(query string) string {
+	if si.dialect != DialectPostgres {
+		return query
+	}
+
+	var (
+		sb strings.Builder
+		n  int
+	)
+	for _, c := range query {
+		if c != '?' {
+			sb.WriteRune(c)
+			continue
+		}
+		n++
+		sb.WriteString("$" + strconv.Itoa(n))
+	}
+	return sb.String()
+}
+
+// hexAddress returns the lower case hex encoding of the address.
+func hexAddress(addr common.Address) string {
+	return strings.ToLower(addr.Hex())
+}
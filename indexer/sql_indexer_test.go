@@ -0,0 +1,186 @@
+package indexer_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/os/crypto/ethsecp256k1"
+	"github.com/evmos/os/indexer"
+	"github.com/evmos/os/testutil/constants"
+	"github.com/evmos/os/testutil/integration/os/network"
+	utiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/types"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func TestSQLIndexer(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "evmindexer.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	testSQLIndexer(t, db, indexer.DialectSQLite)
+}
+
+// testSQLIndexer indexes a block with an ERC-20 transfer on the given database
+// and checks the queries of the SQLIndexer.
+func testSQLIndexer(t *testing.T, db *sql.DB, dialect indexer.SQLDialect) {
+	t.Helper()
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	token := common.BigToAddress(big.NewInt(1))
+	recipient := common.BigToAddress(big.NewInt(2))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &token,
+		Amount:   big.NewInt(0),
+		GasLimit: 50000,
+	})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	// ERC-20 transfer of 100 tokens
+	transferTopic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	txLog, err := json.Marshal(types.NewLogFromEth(&ethtypes.Log{
+		Address: token,
+		Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(recipient.Bytes())},
+		Data:    common.BigToHash(big.NewInt(100)).Bytes(),
+		TxHash:  txHash,
+		Index:   0,
+	}))
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	results := []*abci.ExecTxResult{
+		{
+			Code:    0,
+			GasUsed: 30000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "30000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: token.Hex()},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: types.AttributeKeyTxLog, Value: string(txLog)},
+				}},
+			},
+		},
+	}
+
+	idxer, err := indexer.NewSQLIndexer(db, dialect, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	_, err = idxer.GetByAddress(from, 1, 1)
+	require.ErrorContains(t, err, "address index is not enabled")
+	idxer = idxer.WithAddressIndex()
+
+	// indexing the block again replaces its rows
+	require.NoError(t, idxer.IndexBlock(block, results))
+	require.NoError(t, idxer.IndexBlock(block, results))
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last)
+
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, uint64(30000), res.GasUsed)
+	require.Equal(t, uint64(30000), res.CumulativeGasUsed)
+	require.False(t, res.Failed)
+
+	res2, err := idxer.GetByBlockAndIndex(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, res, res2)
+
+	_, err = idxer.GetByBlockAndIndex(1, 1)
+	require.ErrorContains(t, err, "tx not found")
+	_, err = idxer.GetByTxHash(common.Hash{})
+	require.ErrorContains(t, err, "tx not found")
+
+	for _, addr := range []common.Address{from, token} {
+		hashes, err := idxer.GetByAddress(addr, 1, 1)
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{txHash}, hashes)
+	}
+	hashes, err := idxer.GetByAddress(recipient, 1, 1)
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+
+	hashes, err = idxer.GetByLogTopic(token, transferTopic, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{txHash}, hashes)
+
+	var (
+		count                              int
+		tokenAddr, fromAddr, toAddr, value string
+	)
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM evm_logs").Scan(&count))
+	require.Equal(t, 1, count)
+	require.NoError(t, db.QueryRow(
+		"SELECT token_address, from_address, to_address, value FROM evm_token_transfers",
+	).Scan(&tokenAddr, &fromAddr, &toAddr, &value))
+	require.Equal(t, strings.ToLower(token.Hex()), tokenAddr)
+	require.Equal(t, strings.ToLower(from.Hex()), fromAddr)
+	require.Equal(t, strings.ToLower(recipient.Hex()), toAddr)
+	require.Equal(t, "100", value)
+}
+
+func TestNewEVMTxIndexer(t *testing.T) {
+	opts := indexer.BackendOptions{HomeDir: t.TempDir(), DBBackend: "memdb", Logger: log.NewNopLogger()}
+
+	idxer, err := indexer.NewEVMTxIndexer(indexer.KVBackend, opts)
+	require.NoError(t, err)
+	require.IsType(t, &indexer.KVIndexer{}, idxer)
+
+	_, err = indexer.NewEVMTxIndexer(indexer.PostgresBackend, opts)
+	require.ErrorContains(t, err, "requires a data source name")
+
+	retentionOpts := opts
+	retentionOpts.DSN = "postgres://localhost/evmindexer"
+	retentionOpts.AddressIndex = true
+	retentionOpts.AddressIndexRetention = 100
+	_, err = indexer.NewEVMTxIndexer(indexer.PostgresBackend, retentionOpts)
+	require.ErrorContains(t, err, "doesn't support an address index retention")
+
+	_, err = indexer.NewEVMTxIndexer("unknown", opts)
+	require.ErrorContains(t, err, "unknown indexer backend")
+
+	require.Error(t, indexer.RegisterBackend(indexer.KVBackend, nil))
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev AuthorizationType defines the kind of authorization granted.
+enum AuthorizationType {
+    // GENERIC authorizes any message of the given message type URL
+    GENERIC,
+    // SEND authorizes bank MsgSend messages up to a spend limit
+    SEND,
+    // STAKE authorizes the staking message of the given message type URL
+    // on a set of validators, optionally up to a maximum amount of tokens
+    STAKE
+}
+
+/// @dev Grant represents an authorization granted by a granter to a grantee.
+struct Grant {
+    /// @dev Address of the account granting the authorization
+    address granter;
+    /// @dev Address of the account receiving the authorization
+    address grantee;
+    /// @dev Kind of the authorization
+    AuthorizationType authorizationType;
+    /// @dev Type URL of the authorized message
+    string msgTypeUrl;
+    /// @dev Spend limit of a send authorization or maximum tokens of a stake
+    /// authorization. Empty if there is no limit.
+    Coin[] spendLimit;
+    /// @dev Allowed recipients of a send authorization or allowed validators of
+    /// a stake authorization
+    address[] allowList;
+    /// @dev Unix time at which the authorization expires, zero if it never expires
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// x/authz module to grant, revoke and execute Cosmos authorizations.
+interface IAuthz {
+    /// @dev This event is emitted when an authorization is granted.
+    /// @param grantee The address that received the authorization.
+    /// @param granter The address that granted the authorization.
+    /// @param methods The message type URLs of the authorized messages.
+    /// @param value The spend limit of the authorization in the EVM denomination,
+    /// or the maximum uint256 value if there is no limit.
+    event Approval(
+        address indexed grantee,
+        address indexed granter,
+        string[] methods,
+        uint256 value
+    );
+
+    /// @dev This event is emitted when an authorization is revoked.
+    /// @param grantee The address that had its authorization revoked.
+    /// @param granter The address that granted the authorization.
+    /// @param methods The message type URLs of the revoked authorizations.
+    event Revocation(
+        address indexed grantee,
+        address indexed granter,
+        string[] methods
+    );
+
+    /// @dev This event is emitted when messages are executed by a grantee.
+    /// @param grantee The address executing the messages.
+    /// @param msgTypeUrls The type URLs of the executed messages.
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// @dev Grants an authorization from the granter to the grantee.
+    /// @param granter The address granting the authorization.
+    /// @param grantee The address receiving the authorization.
+    /// @param authorizationType The kind of authorization to grant.
+    /// @param msgTypeUrl The type URL of the authorized message.
+    /// @param spendLimit The spend limit of a send authorization, or the maximum
+    /// tokens of a stake authorization. Leave empty for no limit on stake authorizations.
+    /// @param allowList The allowed recipients of a send authorization, or the
+    /// allowed validators of a stake authorization.
+    /// @param expiration The unix time at which the authorization expires, zero
+    /// if it should never expire.
+    /// @return success Whether the authorization was granted.
+    function grant(
+        address granter,
+        address grantee,
+        AuthorizationType authorizationType,
+        string calldata msgTypeUrl,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes an authorization from the granter to the grantee.
+    /// @param granter The address that granted the authorization.
+    /// @param grantee The address that received the authorization.
+    /// @param msgTypeUrl The type URL of the authorized message.
+    /// @return success Whether the authorization was revoked.
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes messages on behalf of their signers with the authorizations
+    /// granted to the grantee. Each message is either the JSON encoding of the
+    /// message with its "@type" or a protobuf encoded Any.
+    /// @param grantee The address executing the messages.
+    /// @param msgs The encoded messages to execute.
+    /// @return results The encoded responses of the executed messages.
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev Queries the authorizations from a granter to a grantee.
+    /// @param granter The address that granted the authorizations.
+    /// @param grantee The address that received the authorizations.
+    /// @param msgTypeUrl The type URL of the authorized message, empty to query all.
+    /// @param pagination Defines an optional pagination for the request.
+    /// @return grants The authorizations found.
+    /// @return pageResponse The pagination response for the query.
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries the authorizations granted by a granter.
+    /// @param granter The address that granted the authorizations.
+    /// @param pagination Defines an optional pagination for the request.
+    /// @return grants The authorizations found.
+    /// @return pageResponse The pagination response for the query.
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries the authorizations received by a grantee.
+    /// @param grantee The address that received the authorizations.
+    /// @param pagination Defines an optional pagination for the request.
+    /// @return grants The authorizations found.
+    /// @return pageResponse The pagination response for the query.
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+}
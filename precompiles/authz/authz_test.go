@@ -0,0 +1,156 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	chainutil "github.com/evmos/os/example_chain/testutil"
+	"github.com/evmos/os/precompiles/authz"
+	testconstants "github.com/evmos/os/testutil/constants"
+	utiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/core/vm"
+	"github.com/evmos/os/x/evm/statedb"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestRun() {
+	var (
+		ctx      sdk.Context
+		stateDB  *statedb.StateDB
+		receiver common.Address
+	)
+	testcases := []struct {
+		name        string
+		malleate    func() []byte
+		postCheck   func()
+		readOnly    bool
+		expPass     bool
+		errContains string
+	}{
+		{
+			name: "pass - exec of a send in the evm denom is mirrored in the stateDB",
+			malleate: func() []byte {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(banktypes.NewMsgSend(
+					s.keyring.GetAccAddr(0),
+					receiver.Bytes(),
+					sdk.NewCoins(sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 100)),
+				))
+				s.Require().NoError(err)
+
+				input, err := s.precompile.Pack(authz.ExecMethod, s.keyring.GetAddr(1), [][]byte{bz})
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			postCheck: func() {
+				granterBalance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), testconstants.ExampleAttoDenom)
+
+				// the balances of the stateDB include the executed send
+				s.Require().Equal(big.NewInt(100), stateDB.GetBalance(receiver))
+				s.Require().Equal(
+					new(big.Int).Sub(granterBalance.Amount.BigInt(), big.NewInt(100)),
+					stateDB.GetBalance(s.keyring.GetAddr(0)),
+				)
+
+				// committing the stateDB does not overwrite the executed send
+				s.Require().NoError(stateDB.Commit())
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().Equal(math.NewInt(100), balance.Amount)
+				balance = s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), testconstants.ExampleAttoDenom)
+				s.Require().Equal(granterBalance.Amount.SubRaw(100), balance.Amount)
+			},
+			readOnly: false,
+			expPass:  true,
+		},
+		{
+			name: "fail - exec in a read only call",
+			malleate: func() []byte {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(banktypes.NewMsgSend(
+					s.keyring.GetAccAddr(0),
+					receiver.Bytes(),
+					sdk.NewCoins(sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 100)),
+				))
+				s.Require().NoError(err)
+
+				input, err := s.precompile.Pack(authz.ExecMethod, s.keyring.GetAddr(1), [][]byte{bz})
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			postCheck:   func() {},
+			readOnly:    true,
+			expPass:     false,
+			errContains: "write protection",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stateDB = s.network.GetStateDB()
+			receiver = utiltx.GenerateAddress()
+			baseFee := s.network.App.EVMKeeper.GetBaseFee(ctx)
+
+			// the grantee calls the precompile
+			caller := s.keyring.GetAddr(1)
+			input := tc.malleate()
+
+			contract := vm.NewPrecompile(vm.AccountRef(caller), s.precompile, big.NewInt(0), uint64(1e6))
+			contract.Input = input
+
+			contractAddr := contract.Address()
+			txArgs := evmtypes.EvmTxArgs{
+				ChainID:   evmtypes.GetEthChainConfig().ChainID,
+				Nonce:     0,
+				To:        &contractAddr,
+				Amount:    nil,
+				GasLimit:  100000,
+				GasPrice:  chainutil.ExampleMinGasPrices.BigInt(),
+				GasFeeCap: baseFee,
+				GasTipCap: big.NewInt(1),
+				Accesses:  &gethtypes.AccessList{},
+			}
+			msgEthereumTx, err := s.factory.GenerateMsgEthereumTx(s.keyring.GetPrivKey(1), txArgs)
+			s.Require().NoError(err, "failed to generate Ethereum message")
+
+			signedMsg, err := s.factory.SignMsgEthereumTx(s.keyring.GetPrivKey(1), msgEthereumTx)
+			s.Require().NoError(err, "failed to sign Ethereum message")
+
+			proposerAddress := ctx.BlockHeader().ProposerAddress
+			cfg, err := s.network.App.EVMKeeper.EVMConfig(ctx, proposerAddress)
+			s.Require().NoError(err, "failed to instantiate EVM config")
+
+			signer := gethtypes.LatestSignerForChainID(s.network.GetEIP155ChainID())
+			msg, err := signedMsg.AsMessage(signer, baseFee)
+			s.Require().NoError(err, "failed to instantiate Ethereum message")
+
+			evm := s.network.App.EVMKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+
+			precompiles, found, err := s.network.App.EVMKeeper.GetPrecompileInstance(ctx, contractAddr)
+			s.Require().NoError(err, "failed to instantiate precompile")
+			s.Require().True(found, "not found precompile")
+			evm.WithPrecompiles(precompiles.Map, precompiles.Addresses)
+
+			bz, err := s.precompile.Run(evm, contract, tc.readOnly)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error when running the precompile")
+				s.Require().NotNil(bz, "expected returned bytes not to be nil")
+				tc.postCheck()
+			} else {
+				s.Require().Error(err, "expected error to be returned when running the precompile")
+				s.Require().Nil(bz, "expected returned bytes to be nil")
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
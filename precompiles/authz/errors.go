@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+const (
+	// ErrDifferentOriginFromGranter is raised when the origin address is not the same as the granter address.
+	ErrDifferentOriginFromGranter = "tx origin address %s does not match the granter address %s"
+	// ErrDifferentOriginFromGrantee is raised when the origin address is not the same as the grantee address.
+	ErrDifferentOriginFromGrantee = "tx origin address %s does not match the grantee address %s"
+	// ErrInvalidAuthorizationType is raised when the authorization type is not valid.
+	ErrInvalidAuthorizationType = "invalid authorization type: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type url is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type url: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAllowList is raised when the allow list is not valid.
+	ErrInvalidAllowList = "invalid allow list: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrDisabledMsgType is raised when the message type cannot be granted or executed through the precompile.
+	ErrDisabledMsgType = "message type %s cannot be granted or executed through the authz precompile"
+)
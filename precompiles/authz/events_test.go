@@ -0,0 +1,94 @@
+package authz_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/os/precompiles/authorization"
+	"github.com/evmos/os/precompiles/authz"
+	cmn "github.com/evmos/os/precompiles/common"
+	testconstants "github.com/evmos/os/testutil/constants"
+	"github.com/evmos/os/x/evm/core/vm"
+	"github.com/evmos/os/x/evm/statedb"
+)
+
+func (s *PrecompileTestSuite) TestGrantEvent() {
+	var (
+		stDB   *statedb.StateDB
+		ctx    sdk.Context
+		method = s.precompile.Methods[authz.GrantMethod]
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func(granter, grantee common.Address) []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct Approval event is emitted",
+			func(granter, grantee common.Address) []interface{} {
+				return []interface{}{
+					granter,
+					grantee,
+					authz.AuthorizationTypeSend,
+					sendMsgTypeURL,
+					[]cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(50)}},
+					[]common.Address{},
+					int64(0),
+				}
+			},
+			func() {
+				log := stDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.ABI.Events[authorization.EventTypeApproval]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var approvalEvent authorization.EventApproval
+				err := cmn.UnpackLog(s.precompile.ABI, &approvalEvent, authorization.EventTypeApproval, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(1), approvalEvent.Grantee)
+				s.Require().Equal(s.keyring.GetAddr(0), approvalEvent.Granter)
+				s.Require().Equal([]string{sendMsgTypeURL}, approvalEvent.Methods)
+				s.Require().Equal(big.NewInt(50), approvalEvent.Value)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.Grant(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate(s.keyring.GetAddr(0), s.keyring.GetAddr(1)))
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
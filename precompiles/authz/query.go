@@ -0,0 +1,100 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the authorizations from a granter to a grantee, optionally
+// filtered by message type url.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var output GrantsOutput
+	// the request addresses are already validated
+	granter := common.BytesToAddress(sdk.MustAccAddressFromBech32(req.Granter))
+	grantee := common.BytesToAddress(sdk.MustAccAddressFromBech32(req.Grantee))
+	if err := output.FromGrantsResponse(res, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranterGrants returns the authorizations granted by a granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var output GrantsOutput
+	if err := output.FromGrantAuthorizations(res.Grants, res.Pagination); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranteeGrants returns the authorizations received by a grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var output GrantsOutput
+	if err := output.FromGrantAuthorizations(res.Grants, res.Pagination); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
@@ -0,0 +1,256 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/precompiles/authz"
+	cmn "github.com/evmos/os/precompiles/common"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - authorization not found for message type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - grants for a message type",
+			func() []interface{} {
+				expiration := ctx.BlockTime().Add(time.Hour)
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, &expiration)
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL, nil)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, query.PageRequest{}}
+			},
+			func(bz []byte) {
+				var out authz.GrantsOutput
+				err := s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Grants, 1)
+				s.Require().Equal(s.keyring.GetAddr(0), out.Grants[0].Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), out.Grants[0].Grantee)
+				s.Require().Equal(authz.AuthorizationTypeGeneric, out.Grants[0].AuthorizationType)
+				s.Require().Equal(sendMsgTypeURL, out.Grants[0].MsgTypeUrl)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), out.Grants[0].Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - all grants with pagination",
+			func() []interface{} {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegateMsgTypeURL, nil)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(bz []byte) {
+				var out authz.GrantsOutput
+				err := s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Grants, 1)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			args := tc.malleate()
+			bz, err := s.precompile.Grants(ctx, &method, nil, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - grants to several grantees",
+			func() []interface{} {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(2), sendMsgTypeURL, nil)
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(1), s.keyring.GetAddr(2), sendMsgTypeURL, nil)
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			func(bz []byte) {
+				var out authz.GrantsOutput
+				err := s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Grants, 2)
+				grantees := []common.Address{out.Grants[0].Grantee, out.Grants[1].Grantee}
+				s.Require().ElementsMatch([]common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)}, grantees)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			args := tc.malleate()
+			bz, err := s.precompile.GranterGrants(ctx, &method, nil, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranteeGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - grants from several granters",
+			func() []interface{} {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(2), sendMsgTypeURL, nil)
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(1), s.keyring.GetAddr(2), delegateMsgTypeURL, nil)
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			func(bz []byte) {
+				var out authz.GrantsOutput
+				err := s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Grants, 2)
+				granters := []common.Address{out.Grants[0].Granter, out.Grants[1].Granter}
+				s.Require().ElementsMatch([]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}, granters)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(2), grant.Grantee)
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			args := tc.malleate()
+			bz, err := s.precompile.GranteeGrants(ctx, &method, nil, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/precompiles/authorization"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/x/evm/core/vm"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants an authorization from the granter to the grantee, through the
+// authz message server.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrant(method, args, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, authorization: %s }",
+			msg.Granter, msg.Grantee, msg.Grant.Authorization.TypeUrl,
+		),
+	)
+
+	// If the contract is the granter, we don't need an origin check
+	// Otherwise check if the origin matches the granter address
+	isContractGranter := contract.CallerAddress == granterHexAddr && contract.CallerAddress != origin
+	if !isContractGranter && origin != granterHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromGranter, origin.String(), granterHexAddr.String())
+	}
+
+	if _, err = p.AuthzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	grantAuthz, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitApprovalEvent(ctx, stateDB, granteeHexAddr, granterHexAddr, grantAuthz); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes an authorization from the granter to the grantee, through
+// the authz message server.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgRevoke(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, msg_type_url: %s }",
+			msg.Granter, msg.Grantee, msg.MsgTypeUrl,
+		),
+	)
+
+	// If the contract is the granter, we don't need an origin check
+	// Otherwise check if the origin matches the granter address
+	isContractGranter := contract.CallerAddress == granterHexAddr && contract.CallerAddress != origin
+	if !isContractGranter && origin != granterHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromGranter, origin.String(), granterHexAddr.String())
+	}
+
+	if _, err = p.AuthzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventRevocation{
+			Grantee:  granteeHexAddr,
+			Granter:  granterHexAddr,
+			TypeUrls: []string{msg.MsgTypeUrl},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given messages on behalf of their signers with the
+// authorizations granted to the grantee, through the authz message server.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, typeURLs, err := NewMsgExec(p.cdc, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ grantee: %s, msgs: %v }",
+			msg.Grantee, typeURLs,
+		),
+	)
+
+	// If the contract is the grantee, we don't need an origin check
+	// Otherwise check if the origin matches the grantee address
+	isContractGrantee := contract.CallerAddress == granteeHexAddr && contract.CallerAddress != origin
+	if !isContractGrantee && origin != granteeHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromGrantee, origin.String(), granteeHexAddr.String())
+	}
+
+	// Only the events emitted by the executed messages are needed to mirror
+	// the balance changes to the EVM stateDB.
+	prevEvents := len(ctx.EventManager().Events())
+
+	res, err := p.AuthzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.setExecBalanceChanges(ctx.EventManager().Events()[prevEvents:]); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitExecEvent(ctx, stateDB, granteeHexAddr, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// setExecBalanceChanges mirrors the balance changes of the EVM denom caused by
+// the executed messages to the EVM stateDB, based on the coin spent and coin
+// received events emitted by the bank keeper.
+//
+// NOTE: This prevents the stateDB from overwriting the changed balances in the
+// bank keeper when committing the EVM state.
+func (p *Precompile) setExecBalanceChanges(events sdk.Events) error {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	var entries []cmn.BalanceChangeEntry
+	for _, event := range events {
+		var (
+			addrKey string
+			op      cmn.Operation
+		)
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, op = banktypes.AttributeKeySpender, cmn.Sub
+		case banktypes.EventTypeCoinReceived:
+			addrKey, op = banktypes.AttributeKeyReceiver, cmn.Add
+		default:
+			continue
+		}
+
+		addrAttr, ok := event.GetAttribute(addrKey)
+		if !ok {
+			continue
+		}
+		amountAttr, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		if !ok {
+			continue
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amountAttr.Value)
+		if err != nil {
+			return err
+		}
+		amount := coins.AmountOf(evmDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(addrAttr.Value)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, cmn.NewBalanceChangeEntry(
+			common.BytesToAddress(addr), evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt()), op,
+		))
+	}
+
+	p.SetBalanceChangeEntries(entries...)
+	return nil
+}
@@ -0,0 +1,517 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/precompiles/authorization"
+	"github.com/evmos/os/precompiles/authz"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/testutil"
+	testconstants "github.com/evmos/os/testutil/constants"
+	utiltx "github.com/evmos/os/testutil/tx"
+	erc20types "github.com/evmos/os/x/erc20/types"
+	"github.com/evmos/os/x/evm/core/vm"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+var (
+	sendMsgTypeURL     = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	delegateMsgTypeURL = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantMethod]
+	spendLimit := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(1000)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{}, s.keyring.GetAddr(1), authz.AuthorizationTypeGeneric, sendMsgTypeURL, []cmn.Coin{}, []common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - invalid authorization type",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), uint8(9), sendMsgTypeURL, []cmn.Coin{}, []common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid authorization type",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeGeneric, sendMsgTypeURL, []cmn.Coin{}, []common.Address{}, ctx.BlockTime().Unix() - 1,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"fail - ethereum transactions cannot be granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeGeneric, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), []cmn.Coin{}, []common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - erc20 conversions cannot be granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeGeneric, sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}), []cmn.Coin{}, []common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - vesting account creation cannot be granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeGeneric, sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}), []cmn.Coin{}, []common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - send authorization for another message type",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeSend, delegateMsgTypeURL, spendLimit, []common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid message type url",
+		},
+		{
+			"fail - using a different granter address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(2), s.keyring.GetAddr(1), authz.AuthorizationTypeGeneric, sendMsgTypeURL, []cmn.Coin{}, []common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the granter address",
+		},
+		{
+			"success - generic authorization",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeGeneric, sendMsgTypeURL, []cmn.Coin{}, []common.Address{}, ctx.BlockTime().Unix() + 3600,
+				}
+			},
+			func() {
+				grantAuthz, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL)
+				s.Require().IsType(&sdkauthz.GenericAuthorization{}, grantAuthz)
+				s.Require().NotNil(expiration)
+				s.Require().Equal(ctx.BlockTime().Unix()+3600, expiration.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - send authorization",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeSend, sendMsgTypeURL, spendLimit, []common.Address{s.keyring.GetAddr(2)}, int64(0),
+				}
+			},
+			func() {
+				grantAuthz, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL)
+				sendAuthz, ok := grantAuthz.(*banktypes.SendAuthorization)
+				s.Require().True(ok, "expected a send authorization")
+				s.Require().Equal(math.NewInt(1000), sendAuthz.SpendLimit.AmountOf(testconstants.ExampleAttoDenom))
+				s.Require().Equal([]string{s.keyring.GetAccAddr(2).String()}, sendAuthz.AllowList)
+				s.Require().Nil(expiration)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - stake authorization",
+			func() []interface{} {
+				valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+				s.Require().NoError(err)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), authz.AuthorizationTypeStake, delegateMsgTypeURL, spendLimit, []common.Address{common.BytesToAddress(valAddr)}, int64(0),
+				}
+			},
+			func() {
+				grantAuthz, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), delegateMsgTypeURL)
+				stakeAuthz, ok := grantAuthz.(*stakingtypes.StakeAuthorization)
+				s.Require().True(ok, "expected a stake authorization")
+				s.Require().Equal(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, stakeAuthz.AuthorizationType)
+				s.Require().Equal(math.NewInt(1000), stakeAuthz.MaxTokens.Amount)
+				s.Require().Equal([]string{s.network.GetValidators()[0].OperatorAddress}, stakeAuthz.GetAllowList().Address)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			res, err := s.precompile.Grant(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authorization.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty message type url",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), ""}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid message type url",
+		},
+		{
+			"fail - using a different granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), s.keyring.GetAddr(1), sendMsgTypeURL}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the granter address",
+		},
+		{
+			"fail - authorization does not exist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL}
+			},
+			func() {},
+			200000,
+			true,
+			"authorization not found",
+		},
+		{
+			"success - authorization revoked",
+			func() []interface{} {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL}
+			},
+			func() {
+				grantAuthz, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL)
+				s.Require().Nil(grantAuthz)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			res, err := s.precompile.Revoke(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.ExecMethod]
+	receiver := utiltx.GenerateAddress()
+
+	// the grantee executes a send from the granter to the receiver
+	newMsgSend := func() *banktypes.MsgSend {
+		return banktypes.NewMsgSend(
+			s.keyring.GetAccAddr(0),
+			receiver.Bytes(),
+			sdk.NewCoins(sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 100)),
+		)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - no messages",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{}}
+			},
+			func() {},
+			200000,
+			true,
+			"expected at least one message",
+		},
+		{
+			"fail - invalid message encoding",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{[]byte(`{"@type":"/unknown.Msg"}`)}}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid messages",
+		},
+		{
+			"fail - ethereum transactions cannot be executed",
+			func() []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&evmtypes.MsgEthereumTx{From: s.keyring.GetAddr(0).Hex()})
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - erc20 to coin conversions cannot be executed",
+			func() []interface{} {
+				// the grantee is the signer so no authorization is needed
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&erc20types.MsgConvertERC20{
+					ContractAddress: utiltx.GenerateAddress().Hex(),
+					Amount:          math.NewInt(1),
+					Receiver:        sdk.AccAddress(s.keyring.GetAddr(1).Bytes()).String(),
+					Sender:          s.keyring.GetAddr(1).Hex(),
+				})
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - coin to erc20 conversions cannot be executed",
+			func() []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&erc20types.MsgConvertCoin{
+					Coin:     sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 1),
+					Receiver: s.keyring.GetAddr(1).Hex(),
+					Sender:   sdk.AccAddress(s.keyring.GetAddr(1).Bytes()).String(),
+				})
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - vesting account creation cannot be executed",
+			func() []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&sdkvesting.MsgCreateVestingAccount{
+					FromAddress: sdk.AccAddress(s.keyring.GetAddr(0).Bytes()).String(),
+					ToAddress:   sdk.AccAddress(s.keyring.GetAddr(2).Bytes()).String(),
+					Amount:      sdk.NewCoins(sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 1)),
+					EndTime:     s.network.GetContext().BlockTime().Unix() + 100,
+				})
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - using a different grantee address",
+			func() []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(newMsgSend())
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(2), [][]byte{bz}}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the grantee address",
+		},
+		{
+			"fail - authorization does not exist",
+			func() []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(newMsgSend())
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func() {},
+			200000,
+			true,
+			"authorization not found",
+		},
+		{
+			"success - execute a JSON encoded message",
+			func() []interface{} {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(newMsgSend())
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().Equal(math.NewInt(100), balance.Amount)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - execute a protobuf encoded message",
+			func() []interface{} {
+				s.saveGenericGrant(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, nil)
+				msgAny, err := codectypes.NewAnyWithValue(newMsgSend())
+				s.Require().NoError(err)
+				bz, err := s.network.App.AppCodec().Marshal(msgAny)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{bz}}
+			},
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().Equal(math.NewInt(100), balance.Amount)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile, tc.gas)
+
+			_, err := s.precompile.Exec(ctx, s.keyring.GetAddr(1), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -0,0 +1,524 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/precompiles/authorization"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/utils"
+	erc20types "github.com/evmos/os/x/erc20/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+const (
+	// AuthorizationTypeGeneric defines a generic authorization for any message type.
+	AuthorizationTypeGeneric uint8 = iota
+	// AuthorizationTypeSend defines a bank send authorization with a spend limit.
+	AuthorizationTypeSend
+	// AuthorizationTypeStake defines a staking authorization on a set of validators.
+	AuthorizationTypeStake
+)
+
+// disabledMsgTypes are the message type urls that cannot be granted or executed
+// through the authz precompile. Messages that re-enter the EVM keeper must not be
+// dispatched from within the EVM, as the nested execution would be overwritten
+// by the stateDB of the precompile call when it is committed. Nested executions
+// would bypass this check and vesting account creation is rejected by the authz
+// limiter of the ante handler as well.
+var disabledMsgTypes = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertCoin{}),
+	sdk.MsgTypeURL(&sdkauthz.MsgExec{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+}
+
+// Grant defines an authorization granted by a granter to a grantee.
+type Grant struct {
+	Granter           common.Address
+	Grantee           common.Address
+	AuthorizationType uint8
+	MsgTypeUrl        string //nolint:revive,stylecheck
+	SpendLimit        []cmn.Coin
+	AllowList         []common.Address
+	Expiration        int64
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+	Pagination query.PageRequest
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// GrantsOutput defines the output for the Grants, GranterGrants and GranteeGrants queries.
+type GrantsOutput struct {
+	Grants       []Grant
+	PageResponse query.PageResponse
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeUrls []string
+}
+
+// NewMsgGrant creates a new MsgGrant instance from the given arguments and
+// returns it along with the granter and grantee addresses.
+func NewMsgGrant(method *abi.Method, args []interface{}, blockTime time.Time) (*sdkauthz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	authzType, ok := args[2].(uint8)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAuthorizationType, args[2])
+	}
+
+	msgTypeURL, err := parseMsgTypeURL(args[3])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	var spendLimit []cmn.Coin
+	arguments := abi.Arguments{method.Inputs[4]}
+	if err := arguments.Copy(&spendLimit, []interface{}{args[4]}); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	allowList, ok := args[5].([]common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAllowList, args[5])
+	}
+
+	expirationUnix, ok := args[6].(int64)
+	if !ok || expirationUnix < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[6])
+	}
+
+	var expiration *time.Time
+	if expirationUnix > 0 {
+		t := time.Unix(expirationUnix, 0).UTC()
+		if !t.After(blockTime) {
+			return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, "expiration must be after the current block time")
+		}
+		expiration = &t
+	}
+
+	grantAuthz, err := newAuthorization(authzType, msgTypeURL, spendLimit, allowList)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := sdkauthz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), grantAuthz, expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance from the given arguments and
+// returns it along with the granter and grantee addresses.
+func NewMsgRevoke(args []interface{}) (*sdkauthz.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, err := parseMsgTypeURL(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := sdkauthz.NewMsgRevoke(granter.Bytes(), grantee.Bytes(), msgTypeURL)
+	return &msg, granter, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance from the given arguments and
+// returns it along with the grantee address and the type urls of the
+// messages to execute.
+//
+// Each message is decoded from the JSON encoding of the message with its
+// "@type" field, or otherwise from a protobuf encoded Any.
+func NewMsgExec(cdc codec.Codec, args []interface{}) (*sdkauthz.MsgExec, common.Address, []string, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, nil, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	encodedMsgs, ok := args[1].([][]byte)
+	if !ok || len(encodedMsgs) == 0 {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, "expected at least one message")
+	}
+
+	msgs := make([]sdk.Msg, len(encodedMsgs))
+	typeURLs := make([]string, len(encodedMsgs))
+	for i, bz := range encodedMsgs {
+		var msg sdk.Msg
+		if json.Valid(bz) {
+			if err := cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+				return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, err)
+			}
+		} else {
+			var anyMsg codectypes.Any
+			if err := cdc.Unmarshal(bz, &anyMsg); err != nil {
+				return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, err)
+			}
+			if err := cdc.UnpackAny(&anyMsg, &msg); err != nil {
+				return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, err)
+			}
+		}
+
+		typeURLs[i] = sdk.MsgTypeURL(msg)
+		if slices.Contains(disabledMsgTypes, typeURLs[i]) {
+			return nil, common.Address{}, nil, fmt.Errorf(ErrDisabledMsgType, typeURLs[i])
+		}
+		msgs[i] = msg
+	}
+
+	msg := sdkauthz.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, grantee, typeURLs, nil
+}
+
+// ParseGrantsArgs parses the arguments for the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}) (*sdkauthz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGranter, input.Granter)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &sdkauthz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments for the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}) (*sdkauthz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGranter, input.Granter)
+	}
+
+	return &sdkauthz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments for the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}) (*sdkauthz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &sdkauthz.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrantsResponse populates the GrantsOutput from a Grants query response,
+// which does not include the granter and grantee addresses.
+func (o *GrantsOutput) FromGrantsResponse(res *sdkauthz.QueryGrantsResponse, granter, grantee common.Address) error {
+	o.Grants = make([]Grant, len(res.Grants))
+	for i, g := range res.Grants {
+		grant, err := newGrant(granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(res.Pagination)
+	return nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the response of a
+// GranterGrants or GranteeGrants query.
+func (o *GrantsOutput) FromGrantAuthorizations(grants []*sdkauthz.GrantAuthorization, pageRes *query.PageResponse) error {
+	o.Grants = make([]Grant, len(grants))
+	for i, g := range grants {
+		granter, err := utils.Bech32ToHexAddr(g.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := utils.Bech32ToHexAddr(g.Grantee)
+		if err != nil {
+			return err
+		}
+		grant, err := newGrant(granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return err
+		}
+		o.Grants[i] = grant
+	}
+	o.setPageResponse(pageRes)
+	return nil
+}
+
+// setPageResponse sets the page response of the GrantsOutput if present.
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// newAuthorization builds the SDK authorization of the given type.
+func newAuthorization(authzType uint8, msgTypeURL string, spendLimit []cmn.Coin, allowList []common.Address) (sdkauthz.Authorization, error) {
+	if slices.Contains(disabledMsgTypes, msgTypeURL) {
+		return nil, fmt.Errorf(ErrDisabledMsgType, msgTypeURL)
+	}
+
+	coins, err := toSDKCoins(spendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	switch authzType {
+	case AuthorizationTypeGeneric:
+		return sdkauthz.NewGenericAuthorization(msgTypeURL), nil
+	case AuthorizationTypeSend:
+		if msgTypeURL != sdk.MsgTypeURL(&banktypes.MsgSend{}) {
+			return nil, fmt.Errorf(ErrInvalidMsgTypeURL, msgTypeURL)
+		}
+		allowed := make([]sdk.AccAddress, len(allowList))
+		for i, addr := range allowList {
+			allowed[i] = addr.Bytes()
+		}
+		sendAuthz := banktypes.NewSendAuthorization(coins, allowed)
+		if err := sendAuthz.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		return sendAuthz, nil
+	case AuthorizationTypeStake:
+		stakeAuthzType, err := stakeAuthorizationType(msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+		var maxTokens *sdk.Coin
+		switch len(coins) {
+		case 0:
+		case 1:
+			maxTokens = &coins[0]
+		default:
+			return nil, fmt.Errorf(ErrInvalidSpendLimit, "expected at most one coin for stake authorizations")
+		}
+		allowed := make([]sdk.ValAddress, len(allowList))
+		for i, addr := range allowList {
+			allowed[i] = addr.Bytes()
+		}
+		return stakingtypes.NewStakeAuthorization(allowed, nil, stakeAuthzType, maxTokens)
+	default:
+		return nil, fmt.Errorf(ErrInvalidAuthorizationType, authzType)
+	}
+}
+
+// newGrant converts the given SDK authorization into a Grant. Authorizations
+// other than send and stake authorizations are returned as generic ones for
+// their message type url.
+func newGrant(granter, grantee common.Address, authzAny *codectypes.Any, expiration *time.Time) (Grant, error) {
+	grantAuthz, ok := authzAny.GetCachedValue().(sdkauthz.Authorization)
+	if !ok {
+		return Grant{}, fmt.Errorf("unexpected authorization type %T", authzAny.GetCachedValue())
+	}
+
+	grant := Grant{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: AuthorizationTypeGeneric,
+		MsgTypeUrl:        grantAuthz.MsgTypeURL(),
+		SpendLimit:        []cmn.Coin{},
+		AllowList:         []common.Address{},
+	}
+	if expiration != nil {
+		grant.Expiration = expiration.Unix()
+	}
+
+	switch a := grantAuthz.(type) {
+	case *banktypes.SendAuthorization:
+		grant.AuthorizationType = AuthorizationTypeSend
+		grant.SpendLimit = cmn.NewCoinsResponse(a.SpendLimit)
+		for _, addr := range a.AllowList {
+			hexAddr, err := utils.Bech32ToHexAddr(addr)
+			if err != nil {
+				return Grant{}, err
+			}
+			grant.AllowList = append(grant.AllowList, hexAddr)
+		}
+	case *stakingtypes.StakeAuthorization:
+		grant.AuthorizationType = AuthorizationTypeStake
+		if a.MaxTokens != nil {
+			grant.SpendLimit = cmn.NewCoinsResponse(sdk.NewCoins(*a.MaxTokens))
+		}
+		if allowList := a.GetAllowList(); allowList != nil {
+			for _, addr := range allowList.Address {
+				valAddr, err := sdk.ValAddressFromBech32(addr)
+				if err != nil {
+					return Grant{}, err
+				}
+				grant.AllowList = append(grant.AllowList, common.BytesToAddress(valAddr.Bytes()))
+			}
+		}
+	}
+
+	return grant, nil
+}
+
+// stakeAuthorizationType returns the stake authorization type for the given
+// staking message type url.
+func stakeAuthorizationType(msgTypeURL string) (stakingtypes.AuthorizationType, error) {
+	switch msgTypeURL {
+	case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):
+		return stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil
+	case sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):
+		return stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, nil
+	case sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):
+		return stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, nil
+	case sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}):
+		return stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION, nil
+	default:
+		return stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED, fmt.Errorf(ErrInvalidMsgTypeURL, msgTypeURL)
+	}
+}
+
+// approvalValue returns the value of the Approval event emitted for the given
+// authorization, which is its spend limit in the EVM denomination, or the
+// maximum uint256 value if the authorization has no spend limit.
+func approvalValue(grantAuthz sdkauthz.Authorization) *big.Int {
+	var limit sdk.Coins
+	switch a := grantAuthz.(type) {
+	case *banktypes.SendAuthorization:
+		limit = a.SpendLimit
+	case *stakingtypes.StakeAuthorization:
+		if a.MaxTokens != nil {
+			limit = sdk.NewCoins(*a.MaxTokens)
+		}
+	}
+
+	if limit.Empty() {
+		return abi.MaxUint256
+	}
+	return limit.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt()
+}
+
+// parseGranterAndGrantee parses the granter and grantee addresses, which are
+// the first arguments of the Grant and Revoke transactions.
+func parseGranterAndGrantee(args []interface{}) (common.Address, common.Address, error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
+
+// parseMsgTypeURL parses a non-empty message type url argument.
+func parseMsgTypeURL(arg interface{}) (string, error) {
+	msgTypeURL, ok := arg.(string)
+	if !ok || msgTypeURL == "" {
+		return "", fmt.Errorf(ErrInvalidMsgTypeURL, arg)
+	}
+	return msgTypeURL, nil
+}
+
+// toSDKCoins converts the given coins into valid SDK coins.
+func toSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(ErrInvalidSpendLimit, "nil amount")
+		}
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	return sdkCoins, nil
+}
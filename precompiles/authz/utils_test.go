@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// saveGenericGrant stores a generic authorization from the granter to the grantee
+// for the given message type url.
+func (s *PrecompileTestSuite) saveGenericGrant(ctx sdk.Context, granter, grantee common.Address, msgTypeURL string, expiration *time.Time) {
+	err := s.network.App.AuthzKeeper.SaveGrant(
+		ctx,
+		grantee.Bytes(),
+		granter.Bytes(),
+		sdkauthz.NewGenericAuthorization(msgTypeURL),
+		expiration,
+	)
+	s.Require().NoError(err)
+}
@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,56 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Output specifies the recipient and the coins sent to it by a multiSend.
+struct Output {
+    /// to defines the address of the recipient.
+    address to;
+    /// amount defines the coins sent to the recipient.
+    Coin[] amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module,
+ * and for sending coins of any denomination.
  */
 interface IBank {
+    /// @dev Transfer defines an Event emitted when coins are sent by a send or
+    /// multiSend transaction.
+    /// @param from the address of the sender
+    /// @param to the address of the recipient
+    /// @param denom the denomination of the coins
+    /// @param amount the amount of coins
+    event Transfer(
+        address indexed from,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev send defines a method for sending coins of the given denomination
+    /// from the caller to the given address. The send enabled flags and the
+    /// blocked addresses of the bank module are honoured.
+    /// @param to the address of the recipient.
+    /// @param denom the denomination of the coins.
+    /// @param amount the amount of coins, in the original decimals.
+    /// @return success whether the transaction was successful or not.
+    function send(
+        address to,
+        string calldata denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending coins from the caller to
+    /// several addresses. The send enabled flags and the blocked addresses of
+    /// the bank module are honoured.
+    /// @param outputs the recipients and the coins sent to them.
+    /// @return success whether the transaction was successful or not.
+    function multiSend(
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -0,0 +1,114 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/x/evm/core/vm"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends coins of the given denom from the caller to the given address,
+// through the bank message server, which honours the send enabled flags and
+// the blocked addresses.
+func (p *Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from := contract.CallerAddress
+	msg, to, err := NewMsgSend(from, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err = msgSrv.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	if amount := msg.Amount.AmountOf(evmDenom); amount.IsPositive() {
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(from, convertedAmount, cmn.Sub),
+			cmn.NewBalanceChangeEntry(to, convertedAmount, cmn.Add))
+	}
+
+	if err = p.EmitTransferEvent(ctx, stateDB, from, to, msg.Amount[0]); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends coins from the caller to several addresses, through the
+// bank message server, which honours the send enabled flags and the blocked
+// addresses. A Transfer event is emitted for each coin sent to each address.
+func (p *Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from := contract.CallerAddress
+	msg, outputs, err := NewMsgMultiSend(method, from, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we already charged for a single send so we don't need to charge
+	// for the first transfer
+	transfers := 0
+	for _, output := range msg.Outputs {
+		transfers += len(output.Coins)
+	}
+	ctx.GasMeter().ConsumeGas(uint64(transfers-1)*GasSend, "bank extension multiSend method") //nolint:gosec // G115 // transfers is positive
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err = msgSrv.MultiSend(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	if amount := msg.Inputs[0].Coins.AmountOf(evmDenom); amount.IsPositive() {
+		entries := []cmn.BalanceChangeEntry{
+			cmn.NewBalanceChangeEntry(from, evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt()), cmn.Sub),
+		}
+		for i, output := range msg.Outputs {
+			if amount := output.Coins.AmountOf(evmDenom); amount.IsPositive() {
+				entries = append(entries, cmn.NewBalanceChangeEntry(outputs[i].To, evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt()), cmn.Add))
+			}
+		}
+		p.SetBalanceChangeEntries(entries...)
+	}
+
+	for i, output := range msg.Outputs {
+		for _, coin := range output.Coins {
+			if err = p.EmitTransferEvent(ctx, stateDB, from, outputs[i].To, coin); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
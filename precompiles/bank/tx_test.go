@@ -0,0 +1,247 @@
+package bank_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/os/precompiles/bank"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/testutil"
+	evmosutiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/core/vm"
+	"github.com/evmos/os/x/evm/statedb"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.SendMethod]
+	receiver := evmosutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		postCheck   func()
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - invalid receiver address",
+			func() []interface{} {
+				return []interface{}{"random text", xmplDenom, big.NewInt(1)}
+			},
+			false,
+			"invalid type for to",
+			nil,
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{receiver, xmplDenom, big.NewInt(0)}
+			},
+			false,
+			"amount is not positive",
+			nil,
+		},
+		{
+			"fail - send disabled for the denom",
+			func() []interface{} {
+				s.network.App.BankKeeper.SetSendEnabled(ctx, xmplDenom, false)
+				return []interface{}{receiver, xmplDenom, big.NewInt(1)}
+			},
+			false,
+			"transfers are currently disabled",
+			nil,
+		},
+		{
+			"fail - blocked receiver address",
+			func() []interface{} {
+				blockedAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+				s.Require().True(s.network.App.BankKeeper.BlockedAddr(blockedAddr))
+				return []interface{}{common.BytesToAddress(blockedAddr), xmplDenom, big.NewInt(1)}
+			},
+			false,
+			"is not allowed to receive funds",
+			nil,
+		},
+		{
+			"pass - send a coin without token pair",
+			func() []interface{} {
+				return []interface{}{receiver, "ibc/TOKEN", big.NewInt(1e18)}
+			},
+			true,
+			"",
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), "ibc/TOKEN")
+				s.Require().Equal(math.NewInt(1e18), balance.Amount)
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event bank.EventTransfer
+				err := cmn.UnpackLog(s.precompile.ABI, &event, bank.EventTypeTransfer, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.From)
+				s.Require().Equal(receiver, event.To)
+				s.Require().Equal("ibc/TOKEN", event.Denom)
+				s.Require().Equal(big.NewInt(1e18), event.Amount)
+			},
+		},
+		{
+			"pass - send the EVM denom",
+			func() []interface{} {
+				return []interface{}{receiver, evmtypes.GetEVMCoinDenom(), big.NewInt(1e18)}
+			},
+			true,
+			"",
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), evmtypes.GetEVMCoinDenom())
+				s.Require().Equal(math.NewInt(1e18), balance.Amount)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest() // reset the chain each test
+			ctx = s.mintAndSendCoin(ctx, "ibc/TOKEN", s.keyring.GetAccAddr(0), math.NewInt(1e18))
+			stDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			_, err := s.precompile.Send(ctx, contract, stDB, &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				tc.postCheck()
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	receivers := []common.Address{evmosutiltx.GenerateAddress(), evmosutiltx.GenerateAddress()}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		postCheck   func()
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - no outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{}}
+			},
+			false,
+			"no outputs",
+			nil,
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Amount: []cmn.Coin{{Denom: xmplDenom, Amount: big.NewInt(0)}}},
+				}}
+			},
+			false,
+			"amount is not positive",
+			nil,
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Amount: []cmn.Coin{{Denom: "ibc/TOKEN", Amount: big.NewInt(2e18)}}},
+				}}
+			},
+			false,
+			"insufficient funds",
+			nil,
+		},
+		{
+			"pass - send coins to several addresses",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Amount: []cmn.Coin{
+						{Denom: "ibc/TOKEN", Amount: big.NewInt(4e17)},
+						{Denom: evmtypes.GetEVMCoinDenom(), Amount: big.NewInt(1e18)},
+					}},
+					{To: receivers[1], Amount: []cmn.Coin{{Denom: "ibc/TOKEN", Amount: big.NewInt(6e17)}}},
+				}}
+			},
+			true,
+			"",
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receivers[0].Bytes(), "ibc/TOKEN")
+				s.Require().Equal(math.NewInt(4e17), balance.Amount)
+				balance = s.network.App.BankKeeper.GetBalance(ctx, receivers[0].Bytes(), evmtypes.GetEVMCoinDenom())
+				s.Require().Equal(math.NewInt(1e18), balance.Amount)
+				balance = s.network.App.BankKeeper.GetBalance(ctx, receivers[1].Bytes(), "ibc/TOKEN")
+				s.Require().Equal(math.NewInt(6e17), balance.Amount)
+				balance = s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), "ibc/TOKEN")
+				s.Require().True(balance.IsZero())
+
+				// a Transfer event is emitted for each coin sent to each address
+				s.Require().Len(stDB.Logs(), 3)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest() // reset the chain each test
+			ctx = s.mintAndSendCoin(ctx, "ibc/TOKEN", s.keyring.GetAccAddr(0), math.NewInt(1e18))
+			stDB = s.network.GetStateDB()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			_, err := s.precompile.MultiSend(ctx, contract, stDB, &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				tc.postCheck()
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/os/precompiles/common"
 )
@@ -18,6 +21,21 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// Output contains the recipient and the coins sent to it by a bank MultiSend
+// transaction.
+type Output struct {
+	To     common.Address
+	Amount []cmn.Coin
+}
+
+// EventTransfer defines the event data for the bank Transfer event.
+type EventTransfer struct {
+	From   common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -45,3 +63,81 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// NewMsgSend creates a new bank MsgSend of the given denom from the given
+// sender after parsing the call arguments for the bank Send transaction.
+func NewMsgSend(from common.Address, args []interface{}) (*banktypes.MsgSend, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	coins := sdk.Coins{{Denom: denom, Amount: math.NewIntFromBigInt(amount)}}
+	if err := coins.Validate(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins), to, nil
+}
+
+// NewMsgMultiSend creates a new bank MsgMultiSend with the given sender as
+// single input after parsing the call arguments for the bank MultiSend
+// transaction. The parsed outputs are returned along with the message.
+func NewMsgMultiSend(method *abi.Method, from common.Address, args []interface{}) (*banktypes.MsgMultiSend, []Output, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var outputs []Output
+	if err := method.Inputs.Copy(&outputs, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to Output struct: %s", err)
+	}
+
+	if len(outputs) == 0 {
+		return nil, nil, fmt.Errorf("no outputs")
+	}
+
+	var total sdk.Coins
+	bankOutputs := make([]banktypes.Output, len(outputs))
+	for i, output := range outputs {
+		coins := make(sdk.Coins, len(output.Amount))
+		for j, coin := range output.Amount {
+			if coin.Amount == nil {
+				return nil, nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+			}
+			coins[j] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+		}
+
+		coins = coins.Sort()
+		if err := coins.Validate(); err != nil {
+			return nil, nil, err
+		}
+		if coins.Empty() {
+			return nil, nil, fmt.Errorf("no coins sent to %s", output.To)
+		}
+
+		bankOutputs[i] = banktypes.NewOutput(output.To.Bytes(), coins)
+		total = total.Add(coins...)
+	}
+
+	msg := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(from.Bytes(), total)},
+		Outputs: bankOutputs,
+	}
+
+	return msg, outputs, nil
+}
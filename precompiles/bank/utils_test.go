@@ -41,7 +41,12 @@ func (is *IntegrationTestSuite) setupBankPrecompile() *bank.Precompile {
 
 // mintAndSendXMPLCoin is a helper function to mint and send a coin to a given address.
 func (s *PrecompileTestSuite) mintAndSendXMPLCoin(ctx sdk.Context, addr sdk.AccAddress, amount math.Int) sdk.Context {
-	coins := sdk.NewCoins(sdk.NewCoin(s.tokenDenom, amount))
+	return s.mintAndSendCoin(ctx, s.tokenDenom, addr, amount)
+}
+
+// mintAndSendCoin is a helper function to mint and send a coin of the given denom to a given address.
+func (s *PrecompileTestSuite) mintAndSendCoin(ctx sdk.Context, denom string, addr sdk.AccAddress, amount math.Int) sdk.Context {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
 	err := s.network.App.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins)
 	s.Require().NoError(err)
 	err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins)
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeeGrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IFeeGrant contract's instance.
+IFeeGrant constant FEEGRANT_CONTRACT = IFeeGrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Allowance represents a basic or periodic fee allowance from a granter
+/// to a grantee. For basic allowances the period is zero and the period
+/// fields are empty.
+struct Allowance {
+    /// @dev The address of the account paying the fees
+    address granter;
+    /// @dev The address of the account whose fees are paid
+    address grantee;
+    /// @dev The total amount the grantee can spend, empty for no limit
+    Coin[] spendLimit;
+    /// @dev The unix time at which the allowance expires, zero for no expiration
+    int64 expiration;
+    /// @dev The length of the period in seconds, zero for basic allowances
+    int64 period;
+    /// @dev The amount the grantee can spend in each period
+    Coin[] periodSpendLimit;
+    /// @dev The amount the grantee can still spend in the current period
+    Coin[] periodCanSpend;
+    /// @dev The unix time at which the current period resets
+    int64 periodReset;
+}
+
+/// @author Evmos Team
+/// @title FeeGrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// fee allowances of the x/feegrant module.
+interface IFeeGrant {
+    /// @dev GrantAllowance defines an Event emitted when a fee allowance is
+    /// granted.
+    /// @param granter the address of the account paying the fees
+    /// @param grantee the address of the account whose fees are paid
+    /// @param spendLimit the total amount the grantee can spend
+    /// @param expiration the unix time at which the allowance expires
+    /// @param period the length of the period in seconds
+    /// @param periodSpendLimit the amount the grantee can spend in each period
+    event GrantAllowance(
+        address indexed granter,
+        address indexed grantee,
+        Coin[] spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] periodSpendLimit
+    );
+
+    /// @dev RevokeAllowance defines an Event emitted when a fee allowance is
+    /// revoked.
+    /// @param granter the address of the account paying the fees
+    /// @param grantee the address of the account whose fees are paid
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// TRANSACTIONS
+
+    /// @dev grantAllowance defines a method to grant a fee allowance from the
+    /// granter to the grantee. A zero period grants a basic allowance, while a
+    /// positive period grants a periodic allowance whose spend limit resets
+    /// every period. There can be only one allowance per granter and grantee.
+    /// @param granter The address of the account paying the fees
+    /// @param grantee The address of the account whose fees are paid
+    /// @param spendLimit The total amount the grantee can spend, empty for no limit
+    /// @param expiration The unix time at which the allowance expires, zero for no expiration
+    /// @param period The length of the period in seconds, zero for a basic allowance
+    /// @param periodSpendLimit The amount the grantee can spend in each period
+    /// @return success Whether the transaction was successful or not
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev revokeAllowance defines a method to revoke the fee allowance from
+    /// the granter to the grantee.
+    /// @param granter The address of the account paying the fees
+    /// @param grantee The address of the account whose fees are paid
+    /// @return success Whether the transaction was successful or not
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev allowance returns the fee allowance from the granter to the grantee.
+    /// @param granter The address of the account paying the fees
+    /// @param grantee The address of the account whose fees are paid
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev allowances returns all the fee allowances received by the grantee.
+    /// @param grantee The address of the account whose fees are paid
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances
+    /// @return pageResponse The pagination response
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            Allowance[] memory allowances,
+            PageResponse memory pageResponse
+        );
+}
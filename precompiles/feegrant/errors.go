@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+const (
+	// ErrDifferentOriginFromGranter is raised when the origin address is not the same as the granter address.
+	ErrDifferentOriginFromGranter = "tx origin address %s does not match the granter address %s"
+	// ErrInvalidCoins is raised when the coins are not valid.
+	ErrInvalidCoins = "invalid coins: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidPeriod is raised when the period is not valid.
+	ErrInvalidPeriod = "invalid period: %v"
+	// ErrPeriodSpendLimitWithoutPeriod is raised when a period spend limit is given for a basic allowance.
+	ErrPeriodSpendLimitWithoutPeriod = "period spend limit requires a positive period"
+	// ErrUnknownAllowanceType is raised when the allowance type cannot be represented by the precompile.
+	ErrUnknownAllowanceType = "unknown allowance type: %T"
+)
@@ -0,0 +1,164 @@
+package feegrant_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/feegrant"
+	testconstants "github.com/evmos/os/testutil/constants"
+	"github.com/evmos/os/x/evm/core/vm"
+	"github.com/evmos/os/x/evm/statedb"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowanceEvent() {
+	var (
+		stDB   *statedb.StateDB
+		ctx    sdk.Context
+		method = s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func(granter, grantee common.Address) []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct GrantAllowance event is emitted",
+			func(granter, grantee common.Address) []interface{} {
+				return []interface{}{
+					granter,
+					grantee,
+					[]cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(500)}},
+					int64(0),
+					int64(3600),
+					[]cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(50)}},
+				}
+			},
+			func() {
+				log := stDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.ABI.Events[feegrant.EventTypeGrantAllowance]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var grantEvent feegrant.EventGrantAllowance
+				err := cmn.UnpackLog(s.precompile.ABI, &grantEvent, feegrant.EventTypeGrantAllowance, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), grantEvent.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), grantEvent.Grantee)
+				s.Require().Equal(big.NewInt(500), grantEvent.SpendLimit[0].Amount)
+				s.Require().Zero(grantEvent.Expiration)
+				s.Require().Equal(int64(3600), grantEvent.Period)
+				s.Require().Equal(big.NewInt(50), grantEvent.PeriodSpendLimit[0].Amount)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.GrantAllowance(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate(s.keyring.GetAddr(0), s.keyring.GetAddr(1)))
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowanceEvent() {
+	var (
+		stDB   *statedb.StateDB
+		ctx    sdk.Context
+		method = s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func(granter, grantee common.Address) []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct RevokeAllowance event is emitted",
+			func(granter, grantee common.Address) []interface{} {
+				s.grantBasicAllowance(ctx, granter, grantee, nil)
+				return []interface{}{
+					granter,
+					grantee,
+				}
+			},
+			func() {
+				log := stDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.ABI.Events[feegrant.EventTypeRevokeAllowance]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var revokeEvent feegrant.EventRevokeAllowance
+				err := cmn.UnpackLog(s.precompile.ABI, &revokeEvent, feegrant.EventTypeRevokeAllowance, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), revokeEvent.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), revokeEvent.Grantee)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.RevokeAllowance(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate(s.keyring.GetAddr(0), s.keyring.GetAddr(1)))
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package feegrant_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/feegrant"
+	"github.com/evmos/os/precompiles/testutil"
+	commonfactory "github.com/evmos/os/testutil/integration/common/factory"
+	"github.com/evmos/os/testutil/integration/os/factory"
+	testutiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/core/vm"
+	evmtypes "github.com/evmos/os/x/evm/types"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+)
+
+// General variables used for integration tests
+var (
+	// differentAddr is an address generated for testing purposes that e.g. raises the different origin error
+	differentAddr = testutiltx.GenerateAddress()
+	// callArgs are the default arguments for calling the smart contract
+	callArgs factory.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+	// outOfGasCheck defines the arguments to check if the precompile returns out of gas error
+	outOfGasCheck testutil.LogCheckArgs
+)
+
+func TestKeeperIntegrationTestSuite(t *testing.T) {
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Keeper Suite")
+}
+
+var _ = Describe("Calling feegrant precompile from EOA", func() {
+	var (
+		s                  *PrecompileTestSuite
+		granter, grantee   common.Address
+		spendLimit         []cmn.Coin
+		noPeriodSpendLimit []cmn.Coin
+	)
+
+	BeforeEach(func() {
+		s = new(PrecompileTestSuite)
+		s.SetupTest()
+
+		granter = s.keyring.GetAddr(0)
+		grantee = s.keyring.GetAddr(1)
+		spendLimit = []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}
+		noPeriodSpendLimit = []cmn.Coin{}
+
+		// set the default call arguments
+		callArgs = factory.CallArgs{
+			ContractABI: s.precompile.ABI,
+		}
+		defaultLogCheck = testutil.LogCheckArgs{
+			ABIEvents: s.precompile.ABI.Events,
+		}
+		passCheck = defaultLogCheck.WithExpPass(true)
+		outOfGasCheck = defaultLogCheck.WithErrContains(vm.ErrOutOfGas.Error())
+
+		// reset tx args each test to avoid keeping custom
+		// values of previous tests (e.g. gasLimit)
+		precompileAddr := s.precompile.Address()
+		txArgs = evmtypes.EvmTxArgs{
+			To: &precompileAddr,
+		}
+		txArgs.GasLimit = 200_000
+	})
+
+	// grantAllowance grants a fee allowance from the granter to the grantee
+	// through the precompile and commits the block.
+	grantAllowance := func(period int64, periodSpendLimit []cmn.Coin) {
+		callArgs.MethodName = feegrant.GrantAllowanceMethod
+		callArgs.Args = []interface{}{
+			granter, grantee, spendLimit, int64(0), period, periodSpendLimit,
+		}
+
+		grantCheck := passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance)
+		_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, grantCheck)
+		Expect(err).To(BeNil(), "error while calling the precompile")
+		Expect(s.network.NextBlock()).To(BeNil(), "error on NextBlock")
+	}
+
+	// queryAllowance returns the fee allowance from the granter to the grantee
+	// through the precompile.
+	queryAllowance := func() feegrant.Allowance {
+		callArgs.MethodName = feegrant.AllowanceMethod
+		callArgs.Args = []interface{}{granter, grantee}
+
+		_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+		Expect(err).To(BeNil(), "error while calling the precompile")
+
+		var out feegrant.AllowanceOutput
+		err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, ethRes.Ret)
+		Expect(err).To(BeNil(), "error while unpacking the allowance")
+		return out.Allowance
+	}
+
+	// =====================================
+	// 				TRANSACTIONS
+	// =====================================
+	Describe("Execute GrantAllowance transaction", func() {
+		BeforeEach(func() {
+			callArgs.MethodName = feegrant.GrantAllowanceMethod
+		})
+
+		It("should return error if the provided gasLimit is too low", func() {
+			txArgs.GasLimit = 30000
+			callArgs.Args = []interface{}{
+				granter, grantee, spendLimit, int64(0), int64(0), noPeriodSpendLimit,
+			}
+
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, outOfGasCheck)
+			Expect(err).To(BeNil())
+
+			_, err = s.grpcHandler.GetFeeAllowance(granter.Bytes(), grantee.Bytes())
+			Expect(err).To(HaveOccurred(), "expected no allowance to be granted")
+		})
+
+		It("should return error if the origin is different than the granter", func() {
+			callArgs.Args = []interface{}{
+				differentAddr, grantee, spendLimit, int64(0), int64(0), noPeriodSpendLimit,
+			}
+
+			granterCheck := defaultLogCheck.WithErrContains(feegrant.ErrDifferentOriginFromGranter, granter.String(), differentAddr.String())
+
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, granterCheck)
+			Expect(err).To(BeNil())
+		})
+
+		It("should grant a basic allowance", func() {
+			grantAllowance(0, noPeriodSpendLimit)
+
+			res, err := s.grpcHandler.GetFeeAllowance(granter.Bytes(), grantee.Bytes())
+			Expect(err).To(BeNil())
+			Expect(res.Allowance.Granter).To(Equal(s.keyring.GetAccAddr(0).String()))
+			Expect(res.Allowance.Grantee).To(Equal(s.keyring.GetAccAddr(1).String()))
+
+			allowance := queryAllowance()
+			Expect(allowance.SpendLimit).To(Equal(spendLimit))
+			Expect(allowance.Period).To(BeZero())
+		})
+
+		It("should grant a periodic allowance", func() {
+			periodSpendLimit := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e17)}}
+			grantAllowance(3600, periodSpendLimit)
+
+			allowance := queryAllowance()
+			Expect(allowance.SpendLimit).To(Equal(spendLimit))
+			Expect(allowance.Period).To(Equal(int64(3600)))
+			Expect(allowance.PeriodSpendLimit).To(Equal(periodSpendLimit))
+			Expect(allowance.PeriodCanSpend).To(Equal(periodSpendLimit))
+		})
+
+		It("should pay the fees of the grantee's transactions", func() {
+			grantAllowance(0, noPeriodSpendLimit)
+
+			denom := s.network.GetBaseDenom()
+			granterBalanceBefore, err := s.grpcHandler.GetBalanceFromBank(granter.Bytes(), denom)
+			Expect(err).To(BeNil())
+			granteeBalanceBefore, err := s.grpcHandler.GetBalanceFromBank(grantee.Bytes(), denom)
+			Expect(err).To(BeNil())
+
+			sendAmount := math.NewInt(1000)
+			msg := banktypes.NewMsgSend(
+				grantee.Bytes(),
+				s.keyring.GetAccAddr(2),
+				sdk.NewCoins(sdk.NewCoin(denom, sendAmount)),
+			)
+			res, err := s.factory.CommitCosmosTx(s.keyring.GetPrivKey(1), commonfactory.CosmosTxArgs{
+				Msgs:       []sdk.Msg{msg},
+				FeeGranter: granter.Bytes(),
+			})
+			Expect(err).To(BeNil())
+			Expect(res.IsOK()).To(BeTrue(), "expected the sponsored tx to succeed: %s", res.Log)
+
+			granteeBalanceAfter, err := s.grpcHandler.GetBalanceFromBank(grantee.Bytes(), denom)
+			Expect(err).To(BeNil())
+			Expect(granteeBalanceAfter.Balance.Amount).To(Equal(granteeBalanceBefore.Balance.Amount.Sub(sendAmount)), "expected the grantee to pay no fees")
+
+			granterBalanceAfter, err := s.grpcHandler.GetBalanceFromBank(granter.Bytes(), denom)
+			Expect(err).To(BeNil())
+			feesPaid := granterBalanceBefore.Balance.Amount.Sub(granterBalanceAfter.Balance.Amount)
+			Expect(feesPaid.IsPositive()).To(BeTrue(), "expected the granter to pay the fees")
+
+			allowance := queryAllowance()
+			Expect(allowance.SpendLimit[0].Amount).To(Equal(new(big.Int).Sub(spendLimit[0].Amount, feesPaid.BigInt())), "expected the fees to be deducted from the spend limit")
+		})
+	})
+
+	Describe("Execute RevokeAllowance transaction", func() {
+		BeforeEach(func() {
+			grantAllowance(0, noPeriodSpendLimit)
+			callArgs.MethodName = feegrant.RevokeAllowanceMethod
+		})
+
+		It("should return error if the origin is different than the granter", func() {
+			callArgs.Args = []interface{}{differentAddr, grantee}
+
+			granterCheck := defaultLogCheck.WithErrContains(feegrant.ErrDifferentOriginFromGranter, granter.String(), differentAddr.String())
+
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, granterCheck)
+			Expect(err).To(BeNil())
+
+			_, err = s.grpcHandler.GetFeeAllowance(granter.Bytes(), grantee.Bytes())
+			Expect(err).To(BeNil(), "expected the allowance to remain")
+		})
+
+		It("should revoke the allowance", func() {
+			callArgs.Args = []interface{}{granter, grantee}
+
+			revokeCheck := passCheck.WithExpEvents(feegrant.EventTypeRevokeAllowance)
+
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, revokeCheck)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+			Expect(s.network.NextBlock()).To(BeNil(), "error on NextBlock")
+
+			_, err = s.grpcHandler.GetFeeAllowance(granter.Bytes(), grantee.Bytes())
+			Expect(err).To(HaveOccurred(), "expected the allowance to be revoked")
+		})
+	})
+
+	// =====================================
+	// 				QUERIES
+	// =====================================
+	Describe("Execute queries", func() {
+		It("allowance - should return error if the allowance does not exist", func() {
+			callArgs.MethodName = feegrant.AllowanceMethod
+			callArgs.Args = []interface{}{granter, grantee}
+
+			_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, defaultLogCheck.WithErrContains("fee-grant not found"))
+			Expect(err).To(BeNil())
+		})
+
+		It("allowances - should return the allowances of the grantee", func() {
+			grantAllowance(0, noPeriodSpendLimit)
+
+			callArgs.MethodName = feegrant.AllowancesMethod
+			callArgs.Args = []interface{}{grantee, query.PageRequest{CountTotal: true}}
+
+			_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+
+			var out feegrant.AllowancesOutput
+			err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, ethRes.Ret)
+			Expect(err).To(BeNil(), "error while unpacking the allowances")
+			Expect(out.Allowances).To(HaveLen(1))
+			Expect(out.Allowances[0].Granter).To(Equal(granter))
+			Expect(out.PageResponse.Total).To(Equal(uint64(1)))
+
+			res, err := s.grpcHandler.GetFeeAllowances(grantee.Bytes())
+			Expect(err).To(BeNil())
+			Expect(res.Allowances).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,237 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/feegrant"
+	testconstants "github.com/evmos/os/testutil/constants"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	spendLimit := sdk.NewCoins(sdk.NewCoin(testconstants.ExampleAttoDenom, sdkmath.NewInt(1000)))
+	periodSpendLimit := sdk.NewCoins(sdk.NewCoin(testconstants.ExampleAttoDenom, sdkmath.NewInt(100)))
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1)}
+			},
+			func([]byte) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - allowance does not exist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func([]byte) {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - basic allowance",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(bz []byte) {
+				var out feegrant.AllowanceOutput
+				err := s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Equal(s.keyring.GetAddr(0), out.Allowance.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), out.Allowance.Grantee)
+				s.Require().Equal([]cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(1000)}}, out.Allowance.SpendLimit)
+				s.Require().Zero(out.Allowance.Expiration)
+				s.Require().Zero(out.Allowance.Period)
+				s.Require().Empty(out.Allowance.PeriodSpendLimit)
+				s.Require().Empty(out.Allowance.PeriodCanSpend)
+				s.Require().Zero(out.Allowance.PeriodReset)
+			},
+			false,
+			"",
+		},
+		{
+			"success - periodic allowance restricted to a set of messages",
+			func() []interface{} {
+				expiration := ctx.BlockTime().Add(24 * time.Hour)
+				periodic := &sdkfeegrant.PeriodicAllowance{
+					Basic:            sdkfeegrant.BasicAllowance{SpendLimit: spendLimit, Expiration: &expiration},
+					Period:           time.Hour,
+					PeriodSpendLimit: periodSpendLimit,
+					PeriodCanSpend:   periodSpendLimit,
+					PeriodReset:      ctx.BlockTime().Add(time.Hour),
+				}
+				allowedMsg, err := sdkfeegrant.NewAllowedMsgAllowance(periodic, []string{"/cosmos.bank.v1beta1.MsgSend"})
+				s.Require().NoError(err)
+				err = s.network.App.FeeGrantKeeper.GrantAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), allowedMsg)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(bz []byte) {
+				var out feegrant.AllowanceOutput
+				err := s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Equal([]cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(1000)}}, out.Allowance.SpendLimit)
+				s.Require().Equal(ctx.BlockTime().Add(24*time.Hour).Unix(), out.Allowance.Expiration)
+				s.Require().Equal(int64(3600), out.Allowance.Period)
+				s.Require().Equal([]cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(100)}}, out.Allowance.PeriodSpendLimit)
+				s.Require().Equal(out.Allowance.PeriodSpendLimit, out.Allowance.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), out.Allowance.PeriodReset)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			args := tc.malleate()
+			bz, err := s.precompile.Allowance(ctx, &method, nil, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{}}
+			},
+			func(bz []byte) {
+				var out feegrant.AllowancesOutput
+				err := s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Empty(out.Allowances)
+			},
+			false,
+			"",
+		},
+		{
+			"success - all allowances of the grantee",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), nil)
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(2), s.keyring.GetAddr(1), nil)
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(2), nil)
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{}}
+			},
+			func(bz []byte) {
+				var out feegrant.AllowancesOutput
+				err := s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Allowances, 2)
+				granters := []common.Address{out.Allowances[0].Granter, out.Allowances[1].Granter}
+				s.Require().ElementsMatch([]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(2)}, granters)
+				for _, allowance := range out.Allowances {
+					s.Require().Equal(s.keyring.GetAddr(1), allowance.Grantee)
+					s.Require().Empty(allowance.SpendLimit)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - allowances with pagination",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), nil)
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(2), s.keyring.GetAddr(1), nil)
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(bz []byte) {
+				var out feegrant.AllowancesOutput
+				err := s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Allowances, 1)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			args := tc.malleate()
+			bz, err := s.precompile.Allowances(ctx, &method, nil, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
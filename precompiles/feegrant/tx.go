@@ -0,0 +1,110 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"fmt"
+
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction.
+	GrantAllowanceMethod = "grantAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant
+	// RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantAllowance grants a basic or periodic fee allowance from the granter to
+// the grantee, through the feegrant message server.
+func (p Precompile) GrantAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, input, err := NewMsgGrantAllowance(method, args, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, allowance: %s }",
+			msg.Granter, msg.Grantee, msg.Allowance.TypeUrl,
+		),
+	)
+
+	// If the contract is the granter, we don't need an origin check
+	// Otherwise check if the origin matches the granter address
+	isContractGranter := contract.CallerAddress == input.Granter && contract.CallerAddress != origin
+	if !isContractGranter && origin != input.Granter {
+		return nil, fmt.Errorf(ErrDifferentOriginFromGranter, origin.String(), input.Granter.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantAllowanceEvent(ctx, stateDB, input); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevokeAllowance revokes the fee allowance from the granter to the grantee,
+// through the feegrant message server.
+func (p Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgRevokeAllowance(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s }",
+			msg.Granter, msg.Grantee,
+		),
+	)
+
+	// If the contract is the granter, we don't need an origin check
+	// Otherwise check if the origin matches the granter address
+	isContractGranter := contract.CallerAddress == granterHexAddr && contract.CallerAddress != origin
+	if !isContractGranter && origin != granterHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromGranter, origin.String(), granterHexAddr.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granterHexAddr, granteeHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
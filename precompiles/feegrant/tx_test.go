@@ -0,0 +1,346 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/feegrant"
+	"github.com/evmos/os/precompiles/testutil"
+	testconstants "github.com/evmos/os/testutil/constants"
+	utiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	spendLimit := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(1000)}}
+	periodSpendLimit := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(100)}}
+	newGrantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{}, s.keyring.GetAddr(1), spendLimit, int64(0), int64(0), []cmn.Coin{},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), common.Address{}, spendLimit, int64(0), int64(0), []cmn.Coin{},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, ctx.BlockTime().Unix() - 1, int64(0), []cmn.Coin{},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"fail - negative period",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(-1), periodSpendLimit,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidPeriod, -1),
+		},
+		{
+			"fail - period spend limit without a period",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(0), periodSpendLimit,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			feegrant.ErrPeriodSpendLimitWithoutPeriod,
+		},
+		{
+			"fail - period spend limit with a different denom than the spend limit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(3600), []cmn.Coin{{Denom: "other", Amount: big.NewInt(1)}},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"period spend limit has different currency than basic spend limit",
+		},
+		{
+			"fail - using a different granter address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(2), s.keyring.GetAddr(1), spendLimit, int64(0), int64(0), []cmn.Coin{},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the granter address",
+		},
+		{
+			"fail - granting an allowance to the granter",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(0), spendLimit, int64(0), int64(0), []cmn.Coin{},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), nil)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(0), []cmn.Coin{},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - basic allowance",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, ctx.BlockTime().Unix() + 3600, int64(0), []cmn.Coin{},
+				}
+			},
+			func() {
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok, "expected a basic allowance")
+				s.Require().Equal(math.NewInt(1000), basic.SpendLimit.AmountOf(testconstants.ExampleAttoDenom))
+				s.Require().NotNil(basic.Expiration)
+				s.Require().Equal(ctx.BlockTime().Unix()+3600, basic.Expiration.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - basic allowance without limits",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), int64(0), []cmn.Coin{},
+				}
+			},
+			func() {
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok, "expected a basic allowance")
+				s.Require().Nil(basic.SpendLimit)
+				s.Require().Nil(basic.Expiration)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(3600), periodSpendLimit,
+				}
+			},
+			func() {
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				periodic, ok := allowance.(*sdkfeegrant.PeriodicAllowance)
+				s.Require().True(ok, "expected a periodic allowance")
+				s.Require().Equal(math.NewInt(1000), periodic.Basic.SpendLimit.AmountOf(testconstants.ExampleAttoDenom))
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(math.NewInt(100), periodic.PeriodSpendLimit.AmountOf(testconstants.ExampleAttoDenom))
+				s.Require().Equal(periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), periodic.PeriodReset.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - allowance to a new account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), newGrantee, spendLimit, int64(0), int64(0), []cmn.Coin{},
+				}
+			},
+			func() {
+				_, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), newGrantee.Bytes())
+				s.Require().NoError(err)
+				s.Require().NotNil(s.network.App.AccountKeeper.GetAccount(ctx, newGrantee.Bytes()), "expected the grantee account to be created")
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			res, err := s.precompile.GrantAllowance(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - using a different granter address",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(2), s.keyring.GetAddr(1), nil)
+				return []interface{}{s.keyring.GetAddr(2), s.keyring.GetAddr(1)}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the granter address",
+		},
+		{
+			"fail - allowance does not exist",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {},
+			200000,
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance revoked",
+			func() []interface{} {
+				s.grantBasicAllowance(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), nil)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {
+				_, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().ErrorContains(err, "fee-grant not found")
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			res, err := s.precompile.RevokeAllowance(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/precompiles/authorization"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/utils"
+)
+
+// Allowance defines a basic or periodic fee allowance as represented in the
+// feegrant precompile.
+type Allowance struct {
+	Granter          common.Address
+	Grantee          common.Address
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+	PeriodCanSpend   []cmn.Coin
+	PeriodReset      int64
+}
+
+// GrantAllowanceInput defines the input for the GrantAllowance transaction.
+type GrantAllowanceInput struct {
+	Granter          common.Address
+	Grantee          common.Address
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+}
+
+// EventGrantAllowance defines the event data for the GrantAllowance transaction.
+type EventGrantAllowance struct {
+	Granter          common.Address
+	Grantee          common.Address
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	Allowance Allowance
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesOutput defines the output for the Allowances query.
+type AllowancesOutput struct {
+	Allowances   []Allowance
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance and returns it
+// along with the parsed input. A zero period results in a basic allowance,
+// while a positive period results in a periodic allowance whose first period
+// starts at the given block time.
+func NewMsgGrantAllowance(method *abi.Method, args []interface{}, blockTime time.Time) (*sdkfeegrant.MsgGrantAllowance, *GrantAllowanceInput, error) {
+	if len(args) != 6 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to GrantAllowanceInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, nil, fmt.Errorf(authorization.ErrInvalidGranter, input.Granter)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, nil, fmt.Errorf(authorization.ErrInvalidGrantee, input.Grantee)
+	}
+
+	basic, err := newBasicAllowance(input.SpendLimit, input.Expiration, blockTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowance sdkfeegrant.FeeAllowanceI = basic
+	switch {
+	case input.Period < 0 || input.Period > math.MaxInt64/int64(time.Second):
+		return nil, nil, fmt.Errorf(ErrInvalidPeriod, input.Period)
+	case input.Period == 0 && len(input.PeriodSpendLimit) > 0:
+		return nil, nil, fmt.Errorf(ErrPeriodSpendLimitWithoutPeriod)
+	case input.Period > 0:
+		periodSpendLimit, err := toSDKCoins(input.PeriodSpendLimit)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		period := time.Duration(input.Period) * time.Second
+		allowance = &sdkfeegrant.PeriodicAllowance{
+			Basic:            *basic,
+			Period:           period,
+			PeriodSpendLimit: periodSpendLimit,
+			PeriodCanSpend:   periodSpendLimit,
+			PeriodReset:      blockTime.Add(period),
+		}
+	}
+
+	msg, err := sdkfeegrant.NewMsgGrantAllowance(
+		allowance,
+		sdk.AccAddress(input.Granter.Bytes()),
+		sdk.AccAddress(input.Grantee.Bytes()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return msg, &input, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance and returns
+// it along with the granter and grantee addresses.
+func NewMsgRevokeAllowance(args []interface{}) (*sdkfeegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := sdkfeegrant.NewMsgRevokeAllowance(sdk.AccAddress(granter.Bytes()), sdk.AccAddress(grantee.Bytes()))
+	return &msg, granter, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the Allowance query.
+func ParseAllowanceArgs(args []interface{}) (*sdkfeegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdkfeegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments for the Allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}) (*sdkfeegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &sdkfeegrant.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrant converts the given SDK fee grant into an Allowance.
+//
+// NOTE: allowances restricted to a set of messages are returned as the
+// allowance they wrap.
+func (a *Allowance) FromGrant(grant *sdkfeegrant.Grant) error {
+	granter, err := utils.Bech32ToHexAddr(grant.Granter)
+	if err != nil {
+		return err
+	}
+	grantee, err := utils.Bech32ToHexAddr(grant.Grantee)
+	if err != nil {
+		return err
+	}
+
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	if allowedMsg, ok := allowance.(*sdkfeegrant.AllowedMsgAllowance); ok {
+		if allowance, err = allowedMsg.GetAllowance(); err != nil {
+			return err
+		}
+	}
+
+	*a = Allowance{
+		Granter:          granter,
+		Grantee:          grantee,
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+	}
+
+	switch allowance := allowance.(type) {
+	case *sdkfeegrant.BasicAllowance:
+		a.setBasic(allowance)
+	case *sdkfeegrant.PeriodicAllowance:
+		a.setBasic(&allowance.Basic)
+		a.Period = int64(allowance.Period / time.Second)
+		a.PeriodSpendLimit = cmn.NewCoinsResponse(allowance.PeriodSpendLimit)
+		a.PeriodCanSpend = cmn.NewCoinsResponse(allowance.PeriodCanSpend)
+		a.PeriodReset = allowance.PeriodReset.Unix()
+	default:
+		return fmt.Errorf(ErrUnknownAllowanceType, allowance)
+	}
+
+	return nil
+}
+
+// setBasic sets the spend limit and expiration of the given basic allowance.
+func (a *Allowance) setBasic(basic *sdkfeegrant.BasicAllowance) {
+	a.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		a.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// FromResponse populates the AllowancesOutput from an Allowances query response.
+func (o *AllowancesOutput) FromResponse(res *sdkfeegrant.QueryAllowancesResponse) error {
+	o.Allowances = make([]Allowance, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		if err := o.Allowances[i].FromGrant(grant); err != nil {
+			return err
+		}
+	}
+
+	if res.Pagination != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+
+	return nil
+}
+
+// newBasicAllowance builds a basic allowance with the given spend limit and
+// expiration. An empty spend limit means no limit and a zero expiration means
+// the allowance does not expire.
+func newBasicAllowance(spendLimit []cmn.Coin, expiration int64, blockTime time.Time) (*sdkfeegrant.BasicAllowance, error) {
+	coins, err := toSDKCoins(spendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := &sdkfeegrant.BasicAllowance{SpendLimit: coins}
+	switch {
+	case expiration < 0:
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	case expiration > 0:
+		if expiration <= blockTime.Unix() {
+			return nil, fmt.Errorf(ErrInvalidExpiration, "expiration must be after the current block time")
+		}
+		expirationTime := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expirationTime
+	}
+
+	return basic, nil
+}
+
+// parseGranterAndGrantee parses the granter and grantee addresses, which are
+// the first arguments of the feegrant methods.
+func parseGranterAndGrantee(args []interface{}) (common.Address, common.Address, error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
+
+// toSDKCoins converts the given coins into valid SDK coins. An empty slice
+// results in nil coins, which the feegrant module treats as no limit.
+func toSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	if len(coins) == 0 {
+		return nil, nil
+	}
+
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(ErrInvalidCoins, "nil amount")
+		}
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: sdkmath.NewIntFromBigInt(coin.Amount)}
+	}
+
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidCoins, err)
+	}
+
+	return sdkCoins, nil
+}
@@ -0,0 +1,22 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant_test
+
+import (
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// grantBasicAllowance stores a basic fee allowance from the granter to the
+// grantee with the given spend limit.
+func (s *PrecompileTestSuite) grantBasicAllowance(ctx sdk.Context, granter, grantee common.Address, spendLimit sdk.Coins) {
+	err := s.network.App.FeeGrantKeeper.GrantAllowance(
+		ctx,
+		granter.Bytes(),
+		grantee.Bytes(),
+		&sdkfeegrant.BasicAllowance{SpendLimit: spendLimit},
+	)
+	s.Require().NoError(err)
+}
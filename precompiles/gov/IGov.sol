@@ -110,6 +110,22 @@ interface IGov {
         WeightedVoteOption[] options
     );
 
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the proposal of id
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Deposit defines an Event emitted when a deposit is added to a proposal.
+    /// @param depositor the address of the depositor
+    /// @param proposalId the proposal of id
+    /// @param amount the amount of the deposit
+    event Deposit(address indexed depositor, uint64 proposalId, Coin[] amount);
+
+    /// @dev CancelProposal defines an Event emitted when a proposal is canceled.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the proposal of id
+    event CancelProposal(address indexed proposer, uint64 proposalId);
+
     /// TRANSACTIONS
 
     /// @dev vote defines a method to add a vote on a specific proposal.
@@ -138,6 +154,41 @@ interface IGov {
         string memory metadata
     ) external returns (bool success);
 
+    /// @dev submitProposal defines a method to submit a proposal.
+    /// @param proposer The address of the proposer
+    /// @param proposal The proposal, either as the JSON used by the gov CLI
+    /// (messages, metadata, title, summary and expedited), or as the protobuf
+    /// encoding of a MsgSubmitProposal with the messages as Any
+    /// @param deposit The initial deposit of the proposal
+    /// @return proposalId The id of the submitted proposal
+    function submitProposal(
+        address proposer,
+        bytes calldata proposal,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
+    /// @dev deposit defines a method to add a deposit on a specific proposal.
+    /// @param depositor The address of the depositor
+    /// @param proposalId The proposal id
+    /// @param amount The amount of the deposit
+    /// @return success Whether the transaction was successful or not
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev cancelProposal defines a method to cancel a proposal before the
+    /// end of its voting period. The deposits are refunded after charging the
+    /// cancellation fee of the gov params.
+    /// @param proposer The address of the proposer
+    /// @param proposalId The proposal id
+    /// @return success Whether the transaction was successful or not
+    function cancelProposal(
+        address proposer,
+        uint64 proposalId
+    ) external returns (bool success);
+
     /// QUERIES
 
     /// @dev getVote returns the vote of a single voter for a
@@ -25,4 +25,14 @@ const (
 	ErrInvalidWeightedVoteOptionWeight = "invalid weighted vote option weight %s "
 	// ErrInvalidDepositor invalid depositor.
 	ErrInvalidDepositor = "invalid depositor %s "
+	// ErrInvalidProposer invalid proposer.
+	ErrInvalidProposer = "invalid proposer %s "
+	// ErrInvalidProposal invalid proposal.
+	ErrInvalidProposal = "invalid proposal: %s"
+	// ErrInvalidDeposit invalid deposit.
+	ErrInvalidDeposit = "invalid deposit: %s"
+	// ErrDifferentOriginFromProposer is raised when the origin address is not the same as the proposer address.
+	ErrDifferentOriginFromProposer = "tx origin address %s does not match the proposer address %s"
+	// ErrDifferentOriginFromDepositor is raised when the origin address is not the same as the depositor address.
+	ErrDifferentOriginFromDepositor = "tx origin address %s does not match the depositor address %s"
 )
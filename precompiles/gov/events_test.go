@@ -11,6 +11,7 @@ import (
 
 	cmn "github.com/evmos/os/precompiles/common"
 	"github.com/evmos/os/precompiles/gov"
+	testconstants "github.com/evmos/os/testutil/constants"
 	"github.com/evmos/os/x/evm/core/vm"
 	"github.com/evmos/os/x/evm/statedb"
 )
@@ -165,3 +166,78 @@ func (s *PrecompileTestSuite) TestVoteWeightedEvent() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDepositEvent() {
+	var (
+		stDB   *statedb.StateDB
+		ctx    sdk.Context
+		method = s.precompile.Methods[gov.DepositMethod]
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func(depositor common.Address, proposalId uint64, amount []cmn.Coin) []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct Deposit event is emitted",
+			func(depositor common.Address, proposalId uint64, amount []cmn.Coin) []interface{} {
+				return []interface{}{
+					depositor,
+					proposalId,
+					amount,
+				}
+			},
+			func() {
+				log := stDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.ABI.Events[gov.EventTypeDeposit]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var depositEvent gov.EventDeposit
+				err := cmn.UnpackLog(s.precompile.ABI, &depositEvent, gov.EventTypeDeposit, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), depositEvent.Depositor)
+				s.Require().Equal(uint64(1), depositEvent.ProposalId)
+				s.Require().Equal(1, len(depositEvent.Amount))
+				s.Require().Equal(testconstants.ExampleAttoDenom, depositEvent.Amount[0].Denom)
+				s.Require().Equal(big.NewInt(50), depositEvent.Amount[0].Amount)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			amount := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(50)}}
+
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate(s.keyring.GetAddr(0), 1, amount))
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -130,6 +130,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 
 	if s.precompile, err = gov.NewPrecompile(
 		s.network.App.GovKeeper,
+		s.network.App.AppCodec(),
 		s.network.App.AuthzKeeper,
 	); err != nil {
 		panic(err)
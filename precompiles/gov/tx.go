@@ -4,15 +4,20 @@
 package gov
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/evmos/os/precompiles/common"
 	"github.com/evmos/os/x/evm/core/vm"
+	evmtypes "github.com/evmos/os/x/evm/types"
 )
 
 const (
@@ -20,6 +25,12 @@ const (
 	VoteMethod = "vote"
 	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
 	VoteWeightedMethod = "voteWeighted"
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// CancelProposalMethod defines the ABI method name for the gov CancelProposal transaction.
+	CancelProposalMethod = "cancelProposal"
 )
 
 // Vote defines a method to add a vote on a specific proposal.
@@ -87,3 +98,171 @@ func (p Precompile) VoteWeighted(
 
 	return method.Outputs.Pack(true)
 }
+
+// SubmitProposal defines a method to submit a proposal with an initial deposit.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(p.cdc, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.setDepositBalanceChange(proposerHexAddr, msg.InitialDeposit.AmountOf(evmtypes.GetEVMCoinDenom()), cmn.Sub)
+	}
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit defines a method to add a deposit on a specific proposal.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgDeposit(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the depositor, we don't need an origin check
+	// Otherwise check if the origin matches the depositor address
+	isContractDepositor := contract.CallerAddress == depositorHexAddr && contract.CallerAddress != origin
+	if !isContractDepositor && origin != depositorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromDepositor, origin.String(), depositorHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.setDepositBalanceChange(depositorHexAddr, sdk.Coins(msg.Amount).AmountOf(evmtypes.GetEVMCoinDenom()), cmn.Sub)
+	}
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelProposal defines a method to cancel a proposal. The deposits are
+// refunded after charging the cancellation fee of the gov params.
+func (p *Precompile) CancelProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgCancelProposal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	// The refund of the proposer has to be computed before the cancellation,
+	// which removes the deposits.
+	var refund sdkmath.Int
+	if contract.CallerAddress != origin {
+		if refund, err = p.cancellationRefund(ctx, msg.ProposalId, proposerHexAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.CancelProposal(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.setDepositBalanceChange(proposerHexAddr, refund, cmn.Add)
+	}
+
+	if err = p.EmitCancelProposalEvent(ctx, stateDB, proposerHexAddr, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// cancellationRefund returns the amount of the EVM denom refunded to the
+// proposer when cancelling the given proposal, which is its deposit minus the
+// cancellation fee as computed by the gov module.
+func (p Precompile) cancellationRefund(ctx sdk.Context, proposalID uint64, proposer common.Address) (sdkmath.Int, error) {
+	deposit, err := p.govKeeper.Deposits.Get(ctx, collections.Join(proposalID, sdk.AccAddress(proposer.Bytes())))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		// the proposer has no deposit on the proposal
+		return sdkmath.ZeroInt(), nil
+	case err != nil:
+		return sdkmath.Int{}, err
+	}
+
+	params, err := p.govKeeper.Params.Get(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	rate, err := sdkmath.LegacyNewDecFromStr(params.ProposalCancelRatio)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	amount := sdk.Coins(deposit.Amount).AmountOf(evmtypes.GetEVMCoinDenom())
+	return amount.Sub(sdkmath.LegacyNewDecFromInt(amount).Mul(rate).TruncateInt()), nil
+}
+
+// setDepositBalanceChange sets the balance change entry of the given amount
+// of the EVM denom, scaled to 18 decimals, if the amount is positive.
+func (p *Precompile) setDepositBalanceChange(account common.Address, amount sdkmath.Int, op cmn.Operation) {
+	if amount.IsNil() || !amount.IsPositive() {
+		return
+	}
+
+	scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+	p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(account, scaledAmt, op))
+}
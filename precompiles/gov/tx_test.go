@@ -2,15 +2,20 @@ package gov_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/evmos/os/precompiles/common"
 	"github.com/evmos/os/precompiles/gov"
 	"github.com/evmos/os/precompiles/testutil"
+	testconstants "github.com/evmos/os/testutil/constants"
 	utiltx "github.com/evmos/os/testutil/tx"
 	"github.com/evmos/os/x/evm/core/vm"
 )
@@ -276,3 +281,412 @@ func (s *PrecompileTestSuite) TestVoteWeighted() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	newProposerAddr := utiltx.GenerateAddress()
+	deposit := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(100)}}
+
+	jsonProposal := func() []byte {
+		msgJSON, err := s.network.App.AppCodec().MarshalInterfaceJSON(TestProposalMsgs[0])
+		s.Require().NoError(err)
+		return []byte(fmt.Sprintf(`{"messages":[%s],"metadata":"ipfs://CID","title":"test prop","summary":"test prop"}`, msgJSON))
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					jsonProposal(),
+					deposit,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - empty proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte{},
+					deposit,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposal",
+		},
+		{
+			"fail - unknown message type",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte(`{"messages":[{"@type":"/unknown.MsgUnknown"}],"title":"test prop","summary":"test prop"}`),
+					deposit,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposal",
+		},
+		{
+			"fail - invalid deposit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					jsonProposal(),
+					[]cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(0)}},
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid deposit",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					newProposerAddr,
+					jsonProposal(),
+					deposit,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"success - submit proposal given as JSON",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					jsonProposal(),
+					deposit,
+				}
+			},
+			func(bz []byte) {
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+				s.Require().Equal("test prop", proposal.Title)
+				s.Require().Len(proposal.Messages, 1)
+				s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+				s.Require().Equal(math.NewInt(100), sdk.Coins(proposal.TotalDeposit).AmountOf(testconstants.ExampleAttoDenom))
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - submit proposal given as protobuf",
+			func() []interface{} {
+				anyMsg, err := codectypes.NewAnyWithValue(TestProposalMsgs[0])
+				s.Require().NoError(err)
+				bz, err := s.network.App.AppCodec().Marshal(&govv1.MsgSubmitProposal{
+					Messages: []*codectypes.Any{anyMsg},
+					Title:    "test prop",
+					Summary:  "test prop",
+					// the proposer and deposit are given by the arguments
+					Proposer: newProposerAddr.String(),
+				})
+				s.Require().NoError(err)
+
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					bz,
+					deposit,
+				}
+			},
+			func(bz []byte) {
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+				s.Require().Len(proposal.Messages, 1)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.DepositMethod]
+	newDepositorAddr := utiltx.GenerateAddress()
+	const proposalID uint64 = 1
+	amount := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(50)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid depositor address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid depositor",
+		},
+		{
+			"fail - invalid proposal id",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					"1",
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposal id",
+		},
+		{
+			"fail - using a different depositor address",
+			func() []interface{} {
+				return []interface{}{
+					newDepositorAddr,
+					proposalID,
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the depositor address",
+		},
+		{
+			"fail - proposal does not exist",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(10),
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"not found",
+		},
+		{
+			"success - deposit on proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					amount,
+				}
+			},
+			func() {
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				// the deposit is added to the one of the genesis
+				s.Require().Equal(math.NewInt(150), sdk.Coins(deposit.Amount).AmountOf(testconstants.ExampleAttoDenom))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.CancelProposalMethod]
+	newProposerAddr := utiltx.GenerateAddress()
+	const proposalID uint64 = 1
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					newProposerAddr,
+					proposalID,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - not the proposer of the proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(2),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"success - cancel proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+				}
+			},
+			func() {
+				_, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().ErrorIs(err, collections.ErrNotFound)
+				_, err = s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().ErrorIs(err, collections.ErrNotFound)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.CancelProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
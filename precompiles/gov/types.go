@@ -4,8 +4,12 @@
 package gov
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -31,6 +35,36 @@ type EventVoteWeighted struct {
 	Options    WeightedVoteOptions
 }
 
+// EventSubmitProposal defines the event data for the SubmitProposal transaction.
+type EventSubmitProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// EventDeposit defines the event data for the Deposit transaction.
+type EventDeposit struct {
+	Depositor  common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+	Amount     []cmn.Coin
+}
+
+// EventCancelProposal defines the event data for the CancelProposal transaction.
+type EventCancelProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// ProposalJSON defines the JSON format of a proposal given to the SubmitProposal
+// transaction, which is the format of the proposal file of the gov CLI without
+// the deposit.
+type ProposalJSON struct {
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
 // VotesInput defines the input for the Votes query.
 type VotesInput struct {
 	ProposalId uint64 //nolint:revive,stylecheck
@@ -190,6 +224,142 @@ func NewMsgVoteWeighted(method *abi.Method, args []interface{}) (*govv1.MsgVoteW
 	return msg, voterAddress, options, nil
 }
 
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance. The proposal
+// is given either as JSON, with the messages in the JSON format of the codec,
+// or as the protobuf encoding of a MsgSubmitProposal, with the messages as Any.
+// The proposer and the initial deposit of the encoded message are overwritten
+// by the arguments.
+func NewMsgSubmitProposal(cdc codec.Codec, method *abi.Method, args []interface{}) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposal, ok := args[1].([]byte)
+	if !ok || len(proposal) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposal, "empty proposal")
+	}
+
+	deposit, err := parseCoins(method.Inputs[2], args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &govv1.MsgSubmitProposal{}
+	if json.Valid(proposal) {
+		var proposalJSON ProposalJSON
+		if err := json.Unmarshal(proposal, &proposalJSON); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidProposal, err)
+		}
+
+		msgs := make([]*codectypes.Any, len(proposalJSON.Messages))
+		for i, rawMsg := range proposalJSON.Messages {
+			var proposalMsg sdk.Msg
+			if err := cdc.UnmarshalInterfaceJSON(rawMsg, &proposalMsg); err != nil {
+				return nil, common.Address{}, fmt.Errorf(ErrInvalidProposal, err)
+			}
+			if msgs[i], err = codectypes.NewAnyWithValue(proposalMsg); err != nil {
+				return nil, common.Address{}, fmt.Errorf(ErrInvalidProposal, err)
+			}
+		}
+
+		msg.Messages = msgs
+		msg.Metadata = proposalJSON.Metadata
+		msg.Title = proposalJSON.Title
+		msg.Summary = proposalJSON.Summary
+		msg.Expedited = proposalJSON.Expedited
+	} else if err := cdc.Unmarshal(proposal, msg); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposal, err)
+	}
+
+	msg.Proposer = sdk.AccAddress(proposerAddress.Bytes()).String()
+	msg.InitialDeposit = deposit
+
+	return msg, proposerAddress, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance.
+func NewMsgDeposit(method *abi.Method, args []interface{}) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	amount, err := parseCoins(method.Inputs[2], args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &govv1.MsgDeposit{
+		ProposalId: proposalID,
+		Depositor:  sdk.AccAddress(depositorAddress.Bytes()).String(),
+		Amount:     amount,
+	}
+
+	return msg, depositorAddress, nil
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance.
+func NewMsgCancelProposal(args []interface{}) (*govv1.MsgCancelProposal, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	msg := &govv1.MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   sdk.AccAddress(proposerAddress.Bytes()).String(),
+	}
+
+	return msg, proposerAddress, nil
+}
+
+// parseCoins unpacks the given Coin[] argument into valid SDK coins.
+func parseCoins(argument abi.Argument, arg interface{}) (sdk.Coins, error) {
+	var coins []cmn.Coin
+	arguments := abi.Arguments{argument}
+	if err := arguments.Copy(&coins, []interface{}{arg}); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDeposit, err)
+	}
+
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(ErrInvalidDeposit, "nil amount")
+		}
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDeposit, err)
+	}
+
+	return sdkCoins, nil
+}
+
 // ParseVotesArgs parses the arguments for the Votes query.
 func ParseVotesArgs(method *abi.Method, args []interface{}) (*govv1.QueryVotesRequest, error) {
 	if len(args) != 2 {
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IVesting contract's address.
+address constant VESTING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+/// @dev The IVesting contract's instance.
+IVesting constant VESTING_CONTRACT = IVesting(VESTING_PRECOMPILE_ADDRESS);
+
+/// @dev Period defines a length of time and the amount of coins that vests
+/// at its end.
+struct Period {
+    /// @dev Length of the period in seconds
+    int64 length;
+    /// @dev Amount of coins vesting at the end of the period
+    Coin[] amount;
+}
+
+/// @author Evmos Team
+/// @title Vesting Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// vesting accounts of the x/auth/vesting module.
+interface IVesting {
+    /// @dev CreateVestingAccount defines an Event emitted when a continuous or
+    /// delayed vesting account is created.
+    /// @param funder the address of the account funding the vesting account
+    /// @param vestingAddress the address of the vesting account
+    /// @param amount the coins vesting
+    /// @param endTime the unix time at which the coins are fully vested
+    /// @param delayed whether the coins vest all at once at the end time
+    event CreateVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        Coin[] amount,
+        int64 endTime,
+        bool delayed
+    );
+
+    /// @dev CreatePeriodicVestingAccount defines an Event emitted when a
+    /// periodic vesting account is created.
+    /// @param funder the address of the account funding the vesting account
+    /// @param vestingAddress the address of the vesting account
+    /// @param startTime the unix time at which the first period starts
+    /// @param periods the vesting periods
+    event CreatePeriodicVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        int64 startTime,
+        Period[] periods
+    );
+
+    /// TRANSACTIONS
+
+    /// @dev createVestingAccount defines a method to create a vesting account
+    /// funded with the given coins of the funder. The coins vest continuously
+    /// from the block time until the end time, or all at once at the end time
+    /// if delayed. The vesting address must not be an existing account.
+    /// @param funder The address of the account funding the vesting account
+    /// @param vestingAddress The address of the vesting account
+    /// @param amount The coins vesting
+    /// @param endTime The unix time at which the coins are fully vested
+    /// @param delayed Whether the coins vest all at once at the end time
+    /// @return success Whether the transaction was successful or not
+    function createVestingAccount(
+        address funder,
+        address vestingAddress,
+        Coin[] calldata amount,
+        int64 endTime,
+        bool delayed
+    ) external returns (bool success);
+
+    /// @dev createPeriodicVestingAccount defines a method to create a
+    /// periodic vesting account funded with the sum of the coins of the
+    /// periods by the funder. The vesting address must not be an existing
+    /// account.
+    /// @param funder The address of the account funding the vesting account
+    /// @param vestingAddress The address of the vesting account
+    /// @param startTime The unix time at which the first period starts
+    /// @param periods The vesting periods
+    /// @return success Whether the transaction was successful or not
+    function createPeriodicVestingAccount(
+        address funder,
+        address vestingAddress,
+        int64 startTime,
+        Period[] calldata periods
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev balances returns the locked, unvested and vested coins of a
+    /// vesting account at the current block time.
+    /// @param vestingAddress The address of the vesting account
+    /// @return locked The coins that cannot be spent
+    /// @return unvested The coins that have not vested yet
+    /// @return vested The coins that have vested
+    function balances(
+        address vestingAddress
+    )
+        external
+        view
+        returns (
+            Coin[] memory locked,
+            Coin[] memory unvested,
+            Coin[] memory vested
+        );
+}
@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting
+
+const (
+	// ErrDifferentOriginFromFunder is raised when the origin address is not the same as the funder address.
+	ErrDifferentOriginFromFunder = "tx origin address %s does not match the funder address %s"
+	// ErrInvalidFunder is raised when the funder address is not valid.
+	ErrInvalidFunder = "invalid funder address: %s"
+	// ErrInvalidVestingAddress is raised when the vesting account address is not valid.
+	ErrInvalidVestingAddress = "invalid vesting account address: %s"
+	// ErrInvalidCoins is raised when the coins are not valid.
+	ErrInvalidCoins = "invalid coins: %s"
+	// ErrInvalidTime is raised when the given unix time is not valid.
+	ErrInvalidTime = "invalid time: %v"
+	// ErrNotVestingAccount is raised when the account is not a vesting account.
+	ErrNotVestingAccount = "account %s is not a vesting account"
+)
@@ -0,0 +1,106 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+const (
+	// EventTypeCreateVestingAccount defines the event type for the vesting CreateVestingAccount transaction.
+	EventTypeCreateVestingAccount = "CreateVestingAccount"
+	// EventTypeCreatePeriodicVestingAccount defines the event type for the vesting CreatePeriodicVestingAccount transaction.
+	EventTypeCreatePeriodicVestingAccount = "CreatePeriodicVestingAccount"
+)
+
+// EmitCreateVestingAccountEvent creates a new CreateVestingAccount event emitted on a CreateVestingAccount transaction.
+func (p Precompile) EmitCreateVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	amount sdk.Coins,
+	endTime int64,
+	delayed bool,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeCreateVestingAccount]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount), endTime, delayed)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitCreatePeriodicVestingAccountEvent creates a new CreatePeriodicVestingAccount event emitted on a
+// CreatePeriodicVestingAccount transaction.
+func (p Precompile) EmitCreatePeriodicVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	startTime int64,
+	periods []Period,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeCreatePeriodicVestingAccount]
+	topics, err := p.createVestingTopics(event, funder, vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(startTime, periods)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
+
+// createVestingTopics returns the topics for the vesting events, which are
+// indexed by the funder and the vesting account address.
+func (p Precompile) createVestingTopics(event abi.Event, funder, vestingAddress common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(funder)
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(vestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,98 @@
+package vesting_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/vesting"
+	testconstants "github.com/evmos/os/testutil/constants"
+	utiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/core/vm"
+	"github.com/evmos/os/x/evm/statedb"
+)
+
+func (s *PrecompileTestSuite) TestCreateVestingAccountEvent() {
+	var (
+		stDB   *statedb.StateDB
+		ctx    sdk.Context
+		method = s.precompile.Methods[vesting.CreateVestingAccountMethod]
+	)
+	vestingAddress := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func(funder common.Address, amount []cmn.Coin) []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct CreateVestingAccount event is emitted",
+			func(funder common.Address, amount []cmn.Coin) []interface{} {
+				return []interface{}{
+					funder,
+					vestingAddress,
+					amount,
+					ctx.BlockTime().Unix() + 100,
+					true,
+				}
+			},
+			func() {
+				log := stDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.ABI.Events[vesting.EventTypeCreateVestingAccount]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var createEvent vesting.EventCreateVestingAccount
+				err := cmn.UnpackLog(s.precompile.ABI, &createEvent, vesting.EventTypeCreateVestingAccount, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), createEvent.Funder)
+				s.Require().Equal(vestingAddress, createEvent.VestingAddress)
+				s.Require().Equal(1, len(createEvent.Amount))
+				s.Require().Equal(testconstants.ExampleAttoDenom, createEvent.Amount[0].Denom)
+				s.Require().Equal(big.NewInt(50), createEvent.Amount[0].Amount)
+				s.Require().Equal(ctx.BlockTime().Unix()+100, createEvent.EndTime)
+				s.Require().True(createEvent.Delayed)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			amount := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(50)}}
+
+			_, err := s.precompile.CreateVestingAccount(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate(s.keyring.GetAddr(0), amount))
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
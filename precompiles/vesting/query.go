@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+const (
+	// BalancesMethod defines the ABI method name for the vesting Balances query.
+	BalancesMethod = "balances"
+)
+
+// Balances returns the locked, unvested and vested coins of the given
+// vesting account at the current block time.
+func (p Precompile) Balances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	vestingHexAddr, err := ParseBalancesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAddr := sdk.AccAddress(vestingHexAddr.Bytes())
+	vestingAcc, ok := p.accountKeeper.GetAccount(ctx, vestingAddr).(exported.VestingAccount)
+	if !ok {
+		return nil, fmt.Errorf(ErrNotVestingAccount, vestingHexAddr)
+	}
+
+	blockTime := ctx.BlockTime()
+	return method.Outputs.Pack(
+		cmn.NewCoinsResponse(vestingAcc.LockedCoins(blockTime)),
+		cmn.NewCoinsResponse(vestingAcc.GetVestingCoins(blockTime)),
+		cmn.NewCoinsResponse(vestingAcc.GetVestedCoins(blockTime)),
+	)
+}
@@ -0,0 +1,106 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/vesting"
+	testconstants "github.com/evmos/os/testutil/constants"
+	utiltx "github.com/evmos/os/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestBalances() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[vesting.BalancesMethod]
+	vestingAddress := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid vesting address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			func([]byte) {},
+			true,
+			"invalid vesting account address",
+		},
+		{
+			"fail - not a vesting account",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			func([]byte) {},
+			true,
+			"is not a vesting account",
+		},
+		{
+			"success - half vested continuous vesting account",
+			func() []interface{} {
+				msgSrv := sdkvesting.NewMsgServerImpl(s.network.App.AccountKeeper, s.network.App.BankKeeper)
+				_, err := msgSrv.CreateVestingAccount(ctx, &vestingtypes.MsgCreateVestingAccount{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   sdk.AccAddress(vestingAddress.Bytes()).String(),
+					Amount:      sdk.NewCoins(sdk.NewInt64Coin(testconstants.ExampleAttoDenom, 1000)),
+					EndTime:     ctx.BlockTime().Unix() + 100,
+				})
+				s.Require().NoError(err)
+
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(50e9))
+				return []interface{}{vestingAddress}
+			},
+			func(bz []byte) {
+				var out vesting.BalancesOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.BalancesMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+
+				expHalf := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(500)}}
+				s.Require().Equal(expHalf, out.Locked)
+				s.Require().Equal(expHalf, out.Unvested)
+				s.Require().Equal(expHalf, out.Vested)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			args := tc.malleate()
+			bz, err := s.precompile.Balances(ctx, &method, nil, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
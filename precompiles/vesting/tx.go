@@ -0,0 +1,136 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/x/evm/core/vm"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+const (
+	// CreateVestingAccountMethod defines the ABI method name for the vesting
+	// CreateVestingAccount transaction.
+	CreateVestingAccountMethod = "createVestingAccount"
+	// CreatePeriodicVestingAccountMethod defines the ABI method name for the
+	// vesting CreatePeriodicVestingAccount transaction.
+	CreatePeriodicVestingAccountMethod = "createPeriodicVestingAccount"
+)
+
+// CreateVestingAccount creates a new continuous or delayed vesting account
+// funded by the funder with the given amount, through the vesting message server.
+func (p *Precompile) CreateVestingAccount(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderHexAddr, vestingHexAddr, err := NewMsgCreateVestingAccount(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, amount: %s, end_time: %d, delayed: %t }",
+			msg.FromAddress, msg.ToAddress, msg.Amount, msg.EndTime, msg.Delayed,
+		),
+	)
+
+	// If the contract is the funder, we don't need an origin check
+	// Otherwise check if the origin matches the funder address
+	isContractFunder := contract.CallerAddress == funderHexAddr && contract.CallerAddress != origin
+	if !isContractFunder && origin != funderHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromFunder, origin.String(), funderHexAddr.String())
+	}
+
+	msgSrv := vesting.NewMsgServerImpl(p.accountKeeper, p.bankKeeper)
+	if _, err = msgSrv.CreateVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.setFundingBalanceChange(funderHexAddr, vestingHexAddr, msg.Amount)
+
+	if err = p.EmitCreateVestingAccountEvent(ctx, stateDB, funderHexAddr, vestingHexAddr, msg.Amount, msg.EndTime, msg.Delayed); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreatePeriodicVestingAccount creates a new periodic vesting account funded
+// by the funder with the sum of the period amounts, through the vesting
+// message server.
+func (p *Precompile) CreatePeriodicVestingAccount(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderHexAddr, vestingHexAddr, periods, err := NewMsgCreatePeriodicVestingAccount(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, start_time: %d, periods: %v }",
+			msg.FromAddress, msg.ToAddress, msg.StartTime, msg.VestingPeriods,
+		),
+	)
+
+	// If the contract is the funder, we don't need an origin check
+	// Otherwise check if the origin matches the funder address
+	isContractFunder := contract.CallerAddress == funderHexAddr && contract.CallerAddress != origin
+	if !isContractFunder && origin != funderHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromFunder, origin.String(), funderHexAddr.String())
+	}
+
+	msgSrv := vesting.NewMsgServerImpl(p.accountKeeper, p.bankKeeper)
+	if _, err = msgSrv.CreatePeriodicVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	var totalAmount sdk.Coins
+	for _, period := range msg.VestingPeriods {
+		totalAmount = totalAmount.Add(period.Amount...)
+	}
+	p.setFundingBalanceChange(funderHexAddr, vestingHexAddr, totalAmount)
+
+	if err = p.EmitCreatePeriodicVestingAccountEvent(ctx, stateDB, funderHexAddr, vestingHexAddr, msg.StartTime, periods); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// setFundingBalanceChange mirrors the funding of a vesting account with the
+// EVM denom to the EVM stateDB.
+//
+// NOTE: This prevents the stateDB from overwriting the changed balances in the
+// bank keeper when committing the EVM state.
+func (p *Precompile) setFundingBalanceChange(funder, vestingAddress common.Address, amount sdk.Coins) {
+	evmAmount := amount.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !evmAmount.IsPositive() {
+		return
+	}
+
+	convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(evmAmount.BigInt())
+	p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(funder, convertedAmount, cmn.Sub),
+		cmn.NewBalanceChangeEntry(vestingAddress, convertedAmount, cmn.Add))
+}
@@ -0,0 +1,302 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/os/precompiles/common"
+	"github.com/evmos/os/precompiles/testutil"
+	"github.com/evmos/os/precompiles/vesting"
+	testconstants "github.com/evmos/os/testutil/constants"
+	utiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestCreateVestingAccount() {
+	var (
+		ctx            sdk.Context
+		vestingAddress common.Address
+	)
+	method := s.precompile.Methods[vesting.CreateVestingAccountMethod]
+	amount := []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(1000)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - invalid funder address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					vestingAddress,
+					amount,
+					ctx.BlockTime().Unix() + 100,
+					false,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid funder address",
+		},
+		{
+			"fail - invalid end time",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					vestingAddress,
+					amount,
+					int64(0),
+					false,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid time",
+		},
+		{
+			"fail - using a different funder address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					vestingAddress,
+					amount,
+					ctx.BlockTime().Unix() + 100,
+					false,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the funder address",
+		},
+		{
+			"fail - vesting account already exists",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					amount,
+					ctx.BlockTime().Unix() + 100,
+					false,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"already exists",
+		},
+		{
+			"success - create continuous vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					vestingAddress,
+					amount,
+					ctx.BlockTime().Unix() + 100,
+					false,
+				}
+			},
+			func() {
+				acc := s.network.App.AccountKeeper.GetAccount(ctx, vestingAddress.Bytes())
+				vestingAcc, ok := acc.(*vestingtypes.ContinuousVestingAccount)
+				s.Require().True(ok, "expected a continuous vesting account")
+				s.Require().Equal(math.NewInt(1000), vestingAcc.OriginalVesting.AmountOf(testconstants.ExampleAttoDenom))
+
+				balance := s.network.App.BankKeeper.GetBalance(ctx, vestingAddress.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().Equal(math.NewInt(1000), balance.Amount)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - create delayed vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					vestingAddress,
+					amount,
+					ctx.BlockTime().Unix() + 100,
+					true,
+				}
+			},
+			func() {
+				acc := s.network.App.AccountKeeper.GetAccount(ctx, vestingAddress.Bytes())
+				_, ok := acc.(*vestingtypes.DelayedVestingAccount)
+				s.Require().True(ok, "expected a delayed vesting account")
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			vestingAddress = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			res, err := s.precompile.CreateVestingAccount(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreatePeriodicVestingAccount() {
+	var (
+		ctx            sdk.Context
+		vestingAddress common.Address
+	)
+	method := s.precompile.Methods[vesting.CreatePeriodicVestingAccountMethod]
+	periods := []vesting.Period{
+		{Length: 100, Amount: []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(500)}}},
+		{Length: 200, Amount: []cmn.Coin{{Denom: testconstants.ExampleAttoDenom, Amount: big.NewInt(300)}}},
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid vesting address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					common.Address{},
+					ctx.BlockTime().Unix(),
+					periods,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid vesting account address",
+		},
+		{
+			"fail - using a different funder address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					vestingAddress,
+					ctx.BlockTime().Unix(),
+					periods,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the funder address",
+		},
+		{
+			"fail - invalid period length",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					vestingAddress,
+					ctx.BlockTime().Unix(),
+					[]vesting.Period{{Length: 0, Amount: periods[0].Amount}},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid period length",
+		},
+		{
+			"success - create periodic vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					vestingAddress,
+					ctx.BlockTime().Unix(),
+					periods,
+				}
+			},
+			func() {
+				acc := s.network.App.AccountKeeper.GetAccount(ctx, vestingAddress.Bytes())
+				vestingAcc, ok := acc.(*vestingtypes.PeriodicVestingAccount)
+				s.Require().True(ok, "expected a periodic vesting account")
+				s.Require().Len(vestingAcc.VestingPeriods, 2)
+				s.Require().Equal(math.NewInt(800), vestingAcc.OriginalVesting.AmountOf(testconstants.ExampleAttoDenom))
+
+				balance := s.network.App.BankKeeper.GetBalance(ctx, vestingAddress.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().Equal(math.NewInt(800), balance.Amount)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			vestingAddress = utiltx.GenerateAddress()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			res, err := s.precompile.CreatePeriodicVestingAccount(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
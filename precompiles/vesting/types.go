@@ -0,0 +1,186 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/os/precompiles/common"
+)
+
+// Period defines a length of time in seconds and the coins vesting at its end.
+type Period struct {
+	Length int64
+	Amount []cmn.Coin
+}
+
+// EventCreateVestingAccount defines the event data for the CreateVestingAccount transaction.
+type EventCreateVestingAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	Amount         []cmn.Coin
+	EndTime        int64
+	Delayed        bool
+}
+
+// EventCreatePeriodicVestingAccount defines the event data for the CreatePeriodicVestingAccount transaction.
+type EventCreatePeriodicVestingAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	StartTime      int64
+	Periods        []Period
+}
+
+// BalancesOutput defines the output for the Balances query.
+type BalancesOutput struct {
+	Locked   []cmn.Coin
+	Unvested []cmn.Coin
+	Vested   []cmn.Coin
+}
+
+// NewMsgCreateVestingAccount creates a new MsgCreateVestingAccount instance and
+// returns it along with the funder and vesting account addresses.
+func NewMsgCreateVestingAccount(method *abi.Method, args []interface{}) (*vestingtypes.MsgCreateVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	funder, vestingAddress, err := parseAddresses(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	amount, err := parseCoins(method.Inputs[2], args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	endTime, ok := args[3].(int64)
+	if !ok || endTime <= 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidTime, args[3])
+	}
+
+	delayed, ok := args[4].(bool)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "delayed", false, args[4])
+	}
+
+	msg := &vestingtypes.MsgCreateVestingAccount{
+		FromAddress: sdk.AccAddress(funder.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(vestingAddress.Bytes()).String(),
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+
+	return msg, funder, vestingAddress, nil
+}
+
+// NewMsgCreatePeriodicVestingAccount creates a new MsgCreatePeriodicVestingAccount
+// instance and returns it along with the funder and vesting account addresses
+// and the parsed periods.
+func NewMsgCreatePeriodicVestingAccount(method *abi.Method, args []interface{}) (*vestingtypes.MsgCreatePeriodicVestingAccount, common.Address, common.Address, []Period, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	funder, vestingAddress, err := parseAddresses(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, nil, err
+	}
+
+	startTime, ok := args[2].(int64)
+	if !ok || startTime <= 0 {
+		return nil, common.Address{}, common.Address{}, nil, fmt.Errorf(ErrInvalidTime, args[2])
+	}
+
+	var periods []Period
+	arguments := abi.Arguments{method.Inputs[3]}
+	if err := arguments.Copy(&periods, []interface{}{args[3]}); err != nil {
+		return nil, common.Address{}, common.Address{}, nil, fmt.Errorf("error while unpacking args to Period struct: %s", err)
+	}
+
+	vestingPeriods := make(vestingtypes.Periods, len(periods))
+	for i, period := range periods {
+		amount, err := toSDKCoins(period.Amount)
+		if err != nil {
+			return nil, common.Address{}, common.Address{}, nil, err
+		}
+		vestingPeriods[i] = vestingtypes.Period{Length: period.Length, Amount: amount}
+	}
+
+	msg := &vestingtypes.MsgCreatePeriodicVestingAccount{
+		FromAddress:    sdk.AccAddress(funder.Bytes()).String(),
+		ToAddress:      sdk.AccAddress(vestingAddress.Bytes()).String(),
+		StartTime:      startTime,
+		VestingPeriods: vestingPeriods,
+	}
+
+	return msg, funder, vestingAddress, periods, nil
+}
+
+// ParseBalancesArgs parses the arguments for the Balances query.
+func ParseBalancesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	vestingAddress, ok := args[0].(common.Address)
+	if !ok || vestingAddress == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, args[0])
+	}
+
+	return vestingAddress, nil
+}
+
+// parseAddresses parses the funder and vesting account addresses, which are
+// the first arguments of the vesting transactions.
+func parseAddresses(args []interface{}) (common.Address, common.Address, error) {
+	funder, ok := args[0].(common.Address)
+	if !ok || funder == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidFunder, args[0])
+	}
+
+	vestingAddress, ok := args[1].(common.Address)
+	if !ok || vestingAddress == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, args[1])
+	}
+
+	return funder, vestingAddress, nil
+}
+
+// parseCoins unpacks the given Coin[] argument into valid SDK coins.
+func parseCoins(argument abi.Argument, arg interface{}) (sdk.Coins, error) {
+	var coins []cmn.Coin
+	arguments := abi.Arguments{argument}
+	if err := arguments.Copy(&coins, []interface{}{arg}); err != nil {
+		return nil, fmt.Errorf(ErrInvalidCoins, err)
+	}
+
+	return toSDKCoins(coins)
+}
+
+// toSDKCoins converts the given coins into valid SDK coins.
+func toSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(ErrInvalidCoins, "nil amount")
+		}
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidCoins, err)
+	}
+
+	return sdkCoins, nil
+}
@@ -4,10 +4,14 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	"cosmossdk.io/log"
@@ -19,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -30,9 +35,20 @@ import (
 
 // BackendI implements the Cosmos and EVM backend.
 type BackendI interface { //nolint: revive
+	CosmosBackend
 	EVMBackend
 }
 
+// CosmosBackend implements the functionality used by the cosmos namespace to
+// correlate Ethereum transactions and accounts with their Cosmos counterparts.
+// Implemented by Backend.
+type CosmosBackend interface {
+	CosmosTxHashByEthHash(hash common.Hash) (cmtbytes.HexBytes, error)
+	EthTxHashesByCosmosHash(hash cmtbytes.HexBytes) ([]common.Hash, error)
+	GetCosmosTx(hash cmtbytes.HexBytes) (*rpctypes.CosmosTxResult, error)
+	GetCosmosBalances(address sdk.AccAddress, blockNrOrHash rpctypes.BlockNumberOrHash) (sdk.Coins, error)
+}
+
 // EVMBackend implements the functionality shared within ethereum namespaces
 // as defined by EIP-1474: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1474.md
 // Implemented by Backend.
@@ -50,6 +66,8 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+	SetRPCGasCap(gasCap uint64)
+	SetRPCEVMTimeout(timeout time.Duration)
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -87,6 +105,7 @@ type EVMBackend interface {
 	GlobalMinGasPrice() (*big.Int, error)
 	BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
 	CurrentHeader() (*ethtypes.Header, error)
+	LatestBaseFee() (*big.Int, error)
 	PendingTransactions() ([]*sdk.Tx, error)
 	GetCoinbase() (sdk.AccAddress, error)
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
@@ -98,6 +117,8 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*evmostypes.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	GetTransactionsByAddress(address common.Address, fromBlock, toBlock rpctypes.BlockNumber) ([]*rpctypes.RPCTransaction, error)
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
@@ -106,18 +127,49 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
+	BloomBits(bit uint, section uint64) ([]byte, error)
+	LogTopicHeights(addresses []common.Address, topics0 []common.Hash, from, to int64) ([]int64, int64, error)
+
+	// Tx Pool
+	TxPoolContent() (pending, queued map[common.Address][]*evmtypes.MsgEthereumTx, err error)
+	TxPoolContentFrom(address common.Address) (pending, queued []*evmtypes.MsgEthereumTx, err error)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error)
+	IntermediateRoots(block *tmrpctypes.ResultBlock) ([]common.Hash, error)
+	AccountRange(blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage bool) (*rpctypes.AccountRangeResult, error)
+	StorageRangeAt(blockHash common.Hash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (*rpctypes.StorageRangeResult, error)
+
+	// Parity Tracing
+	FlatTraceBlock(block *tmrpctypes.ResultBlock) ([]json.RawMessage, error)
+	FlatTraceTransaction(hash common.Hash) ([]json.RawMessage, error)
+	FilterFlatTraces(args rpctypes.TraceFilterArgs) ([]json.RawMessage, error)
+	ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceResults, error)
+	ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.TraceResults, error)
+	ReplayCall(args evmtypes.TransactionArgs, traceTypes []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.TraceResults, error)
+
+	// Otterscan
+	OtsInternalOperations(hash common.Hash) ([]*rpctypes.OtsInternalOperation, error)
+	OtsTraceTransaction(hash common.Hash) ([]*rpctypes.OtsTraceEntry, error)
+	OtsTransactionError(hash common.Hash) (hexutil.Bytes, error)
+	OtsSearchTransactionsBefore(address common.Address, blockNum uint64, pageSize int) (*rpctypes.OtsTransactionsWithReceipts, error)
+	OtsSearchTransactionsAfter(address common.Address, blockNum uint64, pageSize int) (*rpctypes.OtsTransactionsWithReceipts, error)
+	OtsContractCreator(address common.Address) (*rpctypes.OtsContractCreator, error)
+	OtsTransactionBySenderAndNonce(address common.Address, nonce uint64) (*common.Hash, error)
+	OtsBlockDetails(blockNum rpctypes.BlockNumber) (*rpctypes.OtsBlockDetails, error)
+	OtsBlockTransactions(blockNum rpctypes.BlockNumber, pageNumber, pageSize int) (*rpctypes.OtsBlockTransactions, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -133,6 +185,29 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             evmostypes.EVMTxIndexer
+	feeOracle           *feeOracle
+	runtimeCfg          *runtimeConfig
+}
+
+// runtimeConfig holds the JSON-RPC settings that can be changed while the node
+// is running. It is shared by the backends of all the namespaces.
+type runtimeConfig struct {
+	gasCap     atomic.Uint64
+	evmTimeout atomic.Int64
+}
+
+// runtimeConfigs holds the runtime config of every server context.
+var runtimeConfigs sync.Map
+
+// sharedRuntimeConfig returns the runtime config of the given server context,
+// initialized with the given JSON-RPC config on first use.
+func sharedRuntimeConfig(ctx *server.Context, cfg config.JSONRPCConfig) *runtimeConfig {
+	runtimeCfg := &runtimeConfig{}
+	runtimeCfg.gasCap.Store(cfg.GasCap)
+	runtimeCfg.evmTimeout.Store(int64(cfg.EVMTimeout))
+
+	shared, _ := runtimeConfigs.LoadOrStore(ctx, runtimeCfg)
+	return shared.(*runtimeConfig)
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -153,6 +228,11 @@ func NewBackend(
 		panic(err)
 	}
 
+	// count the CometBFT RPC calls when the metrics are enabled
+	if client, ok := clientCtx.Client.(tmrpcclient.Client); ok && metrics.Enabled {
+		clientCtx = clientCtx.WithClient(newMetricsClient(client))
+	}
+
 	rpcClient, ok := clientCtx.Client.(tmrpcclient.SignClient)
 	if !ok {
 		panic(fmt.Sprintf("invalid rpc client, expected: tmrpcclient.SignClient, got: %T", clientCtx.Client))
@@ -168,5 +248,7 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		feeOracle:           sharedFeeOracle(ctx, appConf.JSONRPC),
+		runtimeCfg:          sharedRuntimeConfig(ctx, appConf.JSONRPC),
 	}
 }
@@ -83,11 +83,12 @@ func (suite *BackendTestSuite) SetupTest() {
 	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), ctx.Logger, clientCtx)
 
 	suite.backend = NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer)
-	suite.backend.cfg.JSONRPC.GasCap = 0
-	suite.backend.cfg.JSONRPC.EVMTimeout = 0
+	suite.backend.SetRPCGasCap(0)
+	suite.backend.SetRPCEVMTimeout(0)
 	suite.backend.cfg.JSONRPC.AllowInsecureUnlock = true
 	suite.backend.queryClient.QueryClient = mocks.NewEVMQueryClient(suite.T())
 	suite.backend.queryClient.FeeMarket = mocks.NewFeeMarketQueryClient(suite.T())
+	suite.backend.queryClient.Bank = mocks.NewBankQueryClient(suite.T())
 	suite.backend.ctx = rpctypes.ContextWithHeight(1)
 
 	// Add codec
@@ -0,0 +1,25 @@
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/evmos/os/rpc/backend/mocks"
+	rpc "github.com/evmos/os/rpc/types"
+)
+
+var _ banktypes.QueryClient = &mocks.BankQueryClient{}
+
+// AllBalances
+func RegisterAllBalances(bankClient *mocks.BankQueryClient, height int64, address sdk.AccAddress, key []byte, balances sdk.Coins, nextKey []byte) {
+	req := &banktypes.QueryAllBalancesRequest{Address: address.String(), Pagination: &query.PageRequest{Key: key}}
+	bankClient.On("AllBalances", rpc.ContextWithHeight(height), req).
+		Return(&banktypes.QueryAllBalancesResponse{Balances: balances, Pagination: &query.PageResponse{NextKey: nextKey}}, nil)
+}
+
+func RegisterAllBalancesError(bankClient *mocks.BankQueryClient, height int64, address sdk.AccAddress) {
+	req := &banktypes.QueryAllBalancesRequest{Address: address.String(), Pagination: &query.PageRequest{}}
+	bankClient.On("AllBalances", rpc.ContextWithHeight(height), req).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
@@ -265,7 +265,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -281,7 +281,12 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -304,6 +309,9 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if err := setCallOverrides(&req, overrides, blockOverrides); err != nil {
+		return 0, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -321,7 +329,10 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -339,6 +350,9 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if err := setCallOverrides(&req, overrides, blockOverrides); err != nil {
+		return nil, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -378,17 +392,17 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 		err    error
 	)
 
-	head, err := b.CurrentHeader()
+	baseFee, err := b.LatestBaseFee()
 	if err != nil {
 		return nil, err
 	}
 
-	if head.BaseFee != nil {
-		result, err = b.SuggestGasTipCap(head.BaseFee)
+	if baseFee != nil {
+		result, err = b.SuggestGasTipCap(baseFee)
 		if err != nil {
 			return nil, err
 		}
-		result = result.Add(result, head.BaseFee)
+		result = result.Add(result, baseFee)
 	} else {
 		result = b.RPCMinGasPrice()
 	}
@@ -405,6 +419,84 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	return (*hexutil.Big)(result), nil
 }
 
+// CreateAccessList returns the access list that the given transaction would
+// use if it was executed on top of the given block, along with the gas used by
+// the transaction with the access list applied.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// The access list is created by executing the call several times, so
+	// make sure the context is canceled once it has completed.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty access list is returned as an empty array, like geth does
+	accessList := ethtypes.AccessList{}
+	if len(res.AccessList) > 0 {
+		accessList = *res.AccessList.ToEthAccessList()
+	}
+
+	return &rpctypes.AccessListResult{
+		Accesslist: &accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// setCallOverrides sets the JSON encoded state and block overrides on the
+// given EthCallRequest.
+func setCallOverrides(req *evmtypes.EthCallRequest, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) error {
+	if overrides != nil {
+		bz, err := json.Marshal(overrides)
+		if err != nil {
+			return err
+		}
+		req.Overrides = bz
+	}
+	if blockOverrides != nil {
+		bz, err := json.Marshal(blockOverrides)
+		if err != nil {
+			return err
+		}
+		req.BlockOverrides = bz
+	}
+	return nil
+}
+
 // handleRevertError returns revert related error.
 func handleRevertError(vmError string, ret []byte) error {
 	if len(vmError) > 0 {
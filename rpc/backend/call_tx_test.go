@@ -13,6 +13,7 @@ import (
 	"github.com/evmos/os/rpc/backend/mocks"
 	rpctypes "github.com/evmos/os/rpc/types"
 	utiltx "github.com/evmos/os/testutil/tx"
+	"github.com/evmos/os/x/evm/core/vm"
 	evmtypes "github.com/evmos/os/x/evm/types"
 	"google.golang.org/grpc/metadata"
 )
@@ -432,7 +433,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -443,6 +444,84 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	req := &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()}
+
+	slot := common.BigToHash(big.NewInt(1))
+	accessList := ethtypes.AccessList{{Address: toAddr, StorageKeys: []common.Hash{slot}}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessListError(queryClient, req)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - empty access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, req, &evmtypes.QueryCreateAccessListResponse{GasUsed: 21000})
+			},
+			&rpctypes.AccessListResult{Accesslist: &ethtypes.AccessList{}, GasUsed: 21000},
+			true,
+		},
+		{
+			"pass - access list with vm error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, req, &evmtypes.QueryCreateAccessListResponse{
+					AccessList: evmtypes.NewAccessList(&accessList),
+					GasUsed:    30000,
+					VmError:    vm.ErrExecutionReverted.Error(),
+				})
+			},
+			&rpctypes.AccessListResult{Accesslist: &accessList, GasUsed: 30000, Error: vm.ErrExecutionReverted.Error()},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -153,6 +153,15 @@ func (b *Backend) FeeHistory(
 	lastBlock rpc.BlockNumber, // the block to start search , to oldest
 	rewardPercentiles []float64, // percentiles to fetch reward
 ) (*rpctypes.FeeHistoryResult, error) {
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p <= rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile: #%d:%f >= #%d:%f", i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
+
 	blockEnd := int64(lastBlock) //#nosec G115 -- checked for int overflow already
 
 	if blockEnd < 0 {
@@ -189,44 +198,21 @@ func (b *Backend) FeeHistory(
 	// rewards should only be calculated if reward percentiles were included
 	calculateRewards := rewardCount != 0
 
-	// fetch block
+	// fetch block fees, from the fee oracle cache if possible
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
 		index := int32(blockID - blockStart) // #nosec G115
-		// tendermint block
-		tendermintblock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(blockID))
-		if tendermintblock == nil {
-			return nil, err
-		}
-
-		// eth block
-		ethBlock, err := b.GetBlockByNumber(rpctypes.BlockNumber(blockID), true)
-		if ethBlock == nil {
-			return nil, err
-		}
-
-		// tendermint block result
-		tendermintBlockResult, err := b.rpcClient.BlockResults(b.ctx, &tendermintblock.Block.Height)
-		if tendermintBlockResult == nil {
-			b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err.Error())
-			return nil, err
-		}
-
-		oneFeeHistory := rpctypes.OneFeeHistory{}
-		err = b.processBlock(tendermintblock, &ethBlock, rewardPercentiles, tendermintBlockResult, &oneFeeHistory)
+		fees, err := b.blockFees(blockID)
 		if err != nil {
 			return nil, err
 		}
 
 		// copy
-		thisBaseFee[index] = (*hexutil.Big)(oneFeeHistory.BaseFee)
-		thisBaseFee[index+1] = (*hexutil.Big)(oneFeeHistory.NextBaseFee)
-		thisGasUsedRatio[index] = oneFeeHistory.GasUsedRatio
+		thisBaseFee[index] = (*hexutil.Big)(fees.baseFee)
+		thisBaseFee[index+1] = (*hexutil.Big)(fees.nextBaseFee)
+		thisGasUsedRatio[index] = fees.gasUsedRatio
 		if calculateRewards {
-			for j := 0; j < rewardCount; j++ {
-				reward[index][j] = (*hexutil.Big)(oneFeeHistory.Reward[j])
-				if reward[index][j] == nil {
-					reward[index][j] = (*hexutil.Big)(big.NewInt(0))
-				}
+			for j, r := range fees.rewards(rewardPercentiles) {
+				reward[index][j] = (*hexutil.Big)(r)
 			}
 		}
 	}
@@ -244,15 +230,21 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// SuggestGasTipCap returns the suggested tip cap.
+// When the fee oracle follows the new blocks, the suggestion is a percentile of
+// the lowest tips paid in the latest blocks. Otherwise, or if there are no
+// recent transactions, we return a positive value to help client to mitigate
+// the base fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
 		return big.NewInt(0), nil
 	}
 
+	if tip, ok := b.feeOracle.suggestTipCap(); ok {
+		return tip, nil
+	}
+
 	params, err := b.queryClient.FeeMarket.Params(b.ctx, &feemarkettypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
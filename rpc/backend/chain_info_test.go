@@ -372,7 +372,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			false,
 		},
 		{
-			"fail - Eth block fetching error",
+			"fail - Tendermint block results fetching error",
 			func(sdk.AccAddress) {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
@@ -384,12 +384,11 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			1,
 			nil,
 			nil,
-			true,
+			false,
 		},
 		{
 			"fail - Invalid base fee",
-			func(validator sdk.AccAddress) {
-				// baseFee := math.NewInt(1)
+			func(sdk.AccAddress) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
@@ -398,8 +397,6 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFeeError(queryClient)
-				RegisterValidatorAccount(queryClient, validator)
-				RegisterConsensusParams(client, 1)
 			},
 			1,
 			1,
@@ -409,8 +406,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		},
 		{
 			"pass - Valid FeeHistoryResults object",
-			func(validator sdk.AccAddress) {
-				var header metadata.MD
+			func(sdk.AccAddress) {
 				baseFee := math.NewInt(1)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -420,9 +416,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
-				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
-				RegisterParams(queryClient, &header, 1)
 			},
 			1,
 			1,
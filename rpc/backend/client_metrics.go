@@ -0,0 +1,151 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// metricsClient is a CometBFT RPC client counting the calls made by the
+// backend, and their errors, in the go-ethereum metrics registry. The calls
+// are named after the CometBFT RPC endpoints.
+type metricsClient struct {
+	cmtrpcclient.Client
+}
+
+// newMetricsClient returns the given client counting its calls.
+func newMetricsClient(client cmtrpcclient.Client) *metricsClient {
+	return &metricsClient{Client: client}
+}
+
+// countCall counts a call to the CometBFT RPC endpoint and returns its result.
+func countCall[T any](endpoint string, res T, err error) (T, error) {
+	metrics.GetOrRegisterCounter(fmt.Sprintf("rpc/cometbft/%s/calls", endpoint), nil).Inc(1)
+	if err != nil {
+		metrics.GetOrRegisterCounter(fmt.Sprintf("rpc/cometbft/%s/errors", endpoint), nil).Inc(1)
+	}
+	return res, err
+}
+
+func (c *metricsClient) ABCIQuery(ctx context.Context, path string, data cmtbytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	res, err := c.Client.ABCIQuery(ctx, path, data)
+	return countCall("abci_query", res, err)
+}
+
+func (c *metricsClient) ABCIQueryWithOptions(
+	ctx context.Context,
+	path string,
+	data cmtbytes.HexBytes,
+	opts cmtrpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	res, err := c.Client.ABCIQueryWithOptions(ctx, path, data, opts)
+	return countCall("abci_query", res, err)
+}
+
+func (c *metricsClient) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	res, err := c.Client.BroadcastTxCommit(ctx, tx)
+	return countCall("broadcast_tx_commit", res, err)
+}
+
+func (c *metricsClient) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	res, err := c.Client.BroadcastTxAsync(ctx, tx)
+	return countCall("broadcast_tx_async", res, err)
+}
+
+func (c *metricsClient) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	res, err := c.Client.BroadcastTxSync(ctx, tx)
+	return countCall("broadcast_tx_sync", res, err)
+}
+
+func (c *metricsClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	res, err := c.Client.Block(ctx, height)
+	return countCall("block", res, err)
+}
+
+func (c *metricsClient) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	res, err := c.Client.BlockByHash(ctx, hash)
+	return countCall("block_by_hash", res, err)
+}
+
+func (c *metricsClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	res, err := c.Client.BlockResults(ctx, height)
+	return countCall("block_results", res, err)
+}
+
+func (c *metricsClient) Header(ctx context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	res, err := c.Client.Header(ctx, height)
+	return countCall("header", res, err)
+}
+
+func (c *metricsClient) HeaderByHash(ctx context.Context, hash cmtbytes.HexBytes) (*coretypes.ResultHeader, error) {
+	res, err := c.Client.HeaderByHash(ctx, hash)
+	return countCall("header_by_hash", res, err)
+}
+
+func (c *metricsClient) Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	res, err := c.Client.Validators(ctx, height, page, perPage)
+	return countCall("validators", res, err)
+}
+
+func (c *metricsClient) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	res, err := c.Client.Tx(ctx, hash, prove)
+	return countCall("tx", res, err)
+}
+
+func (c *metricsClient) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*coretypes.ResultTxSearch, error) {
+	res, err := c.Client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	return countCall("tx_search", res, err)
+}
+
+func (c *metricsClient) BlockSearch(
+	ctx context.Context,
+	query string,
+	page, perPage *int,
+	orderBy string,
+) (*coretypes.ResultBlockSearch, error) {
+	res, err := c.Client.BlockSearch(ctx, query, page, perPage, orderBy)
+	return countCall("block_search", res, err)
+}
+
+func (c *metricsClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	res, err := c.Client.Status(ctx)
+	return countCall("status", res, err)
+}
+
+func (c *metricsClient) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
+	res, err := c.Client.ConsensusParams(ctx, height)
+	return countCall("consensus_params", res, err)
+}
+
+func (c *metricsClient) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	res, err := c.Client.UnconfirmedTxs(ctx, limit)
+	return countCall("unconfirmed_txs", res, err)
+}
+
+func (c *metricsClient) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
+	res, err := c.Client.NumUnconfirmedTxs(ctx)
+	return countCall("num_unconfirmed_txs", res, err)
+}
+
+func (c *metricsClient) Subscribe(
+	ctx context.Context,
+	subscriber, query string,
+	outCapacity ...int,
+) (<-chan coretypes.ResultEvent, error) {
+	res, err := c.Client.Subscribe(ctx, subscriber, query, outCapacity...)
+	return countCall("subscribe", res, err)
+}
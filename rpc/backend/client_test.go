@@ -47,6 +47,18 @@ func RegisterTxSearchError(client *mocks.Client, query string) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Tx
+func RegisterTx(client *mocks.Client, txBz []byte, txResult abci.ExecTxResult) {
+	hash := types.Tx(txBz).Hash()
+	client.On("Tx", rpc.ContextWithHeight(1), hash, false).
+		Return(&cmtrpctypes.ResultTx{Hash: hash, Height: 1, Tx: txBz, TxResult: txResult}, nil)
+}
+
+func RegisterTxError(client *mocks.Client, hash []byte) {
+	client.On("Tx", rpc.ContextWithHeight(1), hash, false).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Broadcast Tx
 func RegisterBroadcastTx(client *mocks.Client, tx types.Tx) {
 	client.On("BroadcastTxSync", context.Background(), tx).
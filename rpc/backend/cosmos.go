@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/os/rpc/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/pkg/errors"
+)
+
+// CosmosTxHashByEthHash returns the hash of the CometBFT transaction that
+// includes the Ethereum transaction identified by the given hash. It returns
+// nil if the transaction is not found.
+func (b *Backend) CosmosTxHashByEthHash(hash common.Hash) (cmtbytes.HexBytes, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil || resBlock == nil {
+		b.logger.Debug("block not found", "height", res.Height)
+		return nil, nil
+	}
+
+	if int(res.TxIndex) >= len(resBlock.Block.Txs) {
+		return nil, errors.Errorf("tx index %d out of bounds of block %d", res.TxIndex, res.Height)
+	}
+
+	return resBlock.Block.Txs[res.TxIndex].Hash(), nil
+}
+
+// EthTxHashesByCosmosHash returns the hashes of the Ethereum transactions
+// included in the CometBFT transaction identified by the given hash. It
+// returns nil if the transaction is not found.
+func (b *Backend) EthTxHashesByCosmosHash(hash cmtbytes.HexBytes) ([]common.Hash, error) {
+	resTx, err := b.rpcClient.Tx(b.ctx, hash, false)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash.String(), "error", err.Error())
+		return nil, nil
+	}
+
+	return b.ethTxHashesFromCosmosTx(resTx.Tx)
+}
+
+// GetCosmosTx returns the raw CometBFT transaction identified by the given hash
+// along with the events and the result code of its execution. It returns nil
+// if the transaction is not found.
+func (b *Backend) GetCosmosTx(hash cmtbytes.HexBytes) (*rpctypes.CosmosTxResult, error) {
+	resTx, err := b.rpcClient.Tx(b.ctx, hash, false)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash.String(), "error", err.Error())
+		return nil, nil
+	}
+
+	ethTxHashes, err := b.ethTxHashesFromCosmosTx(resTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.CosmosTxResult{
+		Hash:        resTx.Hash,
+		Height:      hexutil.Uint64(resTx.Height), //nolint:gosec // G115 // won't exceed uint64
+		Index:       hexutil.Uint(resTx.Index),
+		Code:        resTx.TxResult.Code,
+		Codespace:   resTx.TxResult.Codespace,
+		Log:         resTx.TxResult.Log,
+		GasWanted:   hexutil.Uint64(resTx.TxResult.GasWanted), //nolint:gosec // G115 // gas is never negative
+		GasUsed:     hexutil.Uint64(resTx.TxResult.GasUsed),   //nolint:gosec // G115 // gas is never negative
+		Events:      resTx.TxResult.Events,
+		Tx:          hexutil.Bytes(resTx.Tx),
+		EthTxHashes: ethTxHashes,
+	}, nil
+}
+
+// GetCosmosBalances returns the bank balances of all the denominations held
+// by the given account at the given block.
+func (b *Backend) GetCosmosBalances(address sdk.AccAddress, blockNrOrHash rpctypes.BlockNumberOrHash) (sdk.Coins, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	balances := sdk.Coins{}
+	var nextKey []byte
+	for {
+		req := &banktypes.QueryAllBalancesRequest{
+			Address:    address.String(),
+			Pagination: &query.PageRequest{Key: nextKey},
+		}
+		res, err := b.queryClient.Bank.AllBalances(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	return balances, nil
+}
+
+// ethTxHashesFromCosmosTx decodes the given CometBFT transaction and returns
+// the hashes of the Ethereum transactions it contains.
+func (b *Backend) ethTxHashesFromCosmosTx(txBz []byte) ([]common.Hash, error) {
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode tx")
+	}
+
+	hashes := []common.Hash{}
+	for _, msg := range tx.GetMsgs() {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			hashes = append(hashes, ethMsg.AsTransaction().Hash())
+		}
+	}
+	return hashes, nil
+}
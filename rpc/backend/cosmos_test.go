@@ -0,0 +1,224 @@
+package backend
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/os/indexer"
+	"github.com/evmos/os/rpc/backend/mocks"
+	rpctypes "github.com/evmos/os/rpc/types"
+	utiltx "github.com/evmos/os/testutil/tx"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestCosmosTxHashByEthHash() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: ChainID}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	txResults := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		hash         common.Hash
+		expHash      cmtbytes.HexBytes
+	}{
+		{
+			"pass - tx not found",
+			func() {},
+			common.Hash{},
+			nil,
+		},
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			txHash,
+			nil,
+		},
+		{
+			"pass - returns the cosmos tx hash",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+			},
+			txHash,
+			types.Tx(txBz).Hash(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, txResults)
+			suite.Require().NoError(err)
+
+			hash, err := suite.backend.CosmosTxHashByEthHash(tc.hash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expHash, hash)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetCosmosTx() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	cosmosHash := types.Tx(txBz).Hash()
+
+	txResult := abci.ExecTxResult{
+		Code:      5,
+		Codespace: "sdk",
+		Log:       "insufficient funds",
+		GasWanted: 100000,
+		GasUsed:   21000,
+		Events: []abci.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expRes       *rpctypes.CosmosTxResult
+	}{
+		{
+			"pass - tx not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterTxError(client, cosmosHash)
+			},
+			nil,
+		},
+		{
+			"pass - returns the tx and its result",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterTx(client, txBz, txResult)
+			},
+			&rpctypes.CosmosTxResult{
+				Hash:        cosmosHash,
+				Height:      1,
+				Index:       0,
+				Code:        5,
+				Codespace:   "sdk",
+				Log:         "insufficient funds",
+				GasWanted:   100000,
+				GasUsed:     21000,
+				Events:      txResult.Events,
+				Tx:          hexutil.Bytes(txBz),
+				EthTxHashes: []common.Hash{txHash},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			res, err := suite.backend.GetCosmosTx(cosmosHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRes, res)
+
+			hashes, err := suite.backend.EthTxHashesByCosmosHash(cosmosHash)
+			suite.Require().NoError(err)
+			if tc.expRes == nil {
+				suite.Require().Nil(hashes)
+			} else {
+				suite.Require().Equal(tc.expRes.EthTxHashes, hashes)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetCosmosBalances() {
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	blockNum := rpctypes.BlockNumber(1)
+	bn := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+	nextKey := []byte("next")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expBalances  sdk.Coins
+		expPass      bool
+	}{
+		{
+			"fail - query error",
+			func() {
+				bankClient := suite.backend.queryClient.Bank.(*mocks.BankQueryClient)
+				RegisterAllBalancesError(bankClient, 1, addr)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - no balances",
+			func() {
+				bankClient := suite.backend.queryClient.Bank.(*mocks.BankQueryClient)
+				RegisterAllBalances(bankClient, 1, addr, nil, nil, nil)
+			},
+			sdk.Coins{},
+			true,
+		},
+		{
+			"pass - balances over multiple pages",
+			func() {
+				bankClient := suite.backend.queryClient.Bank.(*mocks.BankQueryClient)
+				RegisterAllBalances(bankClient, 1, addr, nil, sdk.NewCoins(sdk.NewCoin("abc", math.NewInt(1))), nextKey)
+				RegisterAllBalances(bankClient, 1, addr, nextKey, sdk.NewCoins(sdk.NewCoin("def", math.NewInt(2))), nil)
+			},
+			sdk.NewCoins(sdk.NewCoin("abc", math.NewInt(1)), sdk.NewCoin("def", math.NewInt(2))),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			balances, err := suite.backend.GetCosmosBalances(addr, bn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBalances, balances)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -50,6 +51,36 @@ func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, height int64) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryTraceCallRequest")).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryTraceCallRequest")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// IntermediateRoots
+func RegisterIntermediateRoots(queryClient *mocks.EVMQueryClient, height int64, roots [][]byte) {
+	queryClient.On("IntermediateRoots", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryIntermediateRootsRequest")).
+		Return(&evmtypes.QueryIntermediateRootsResponse{Roots: roots}, nil)
+}
+
+// AccountRange
+func RegisterAccountRange(queryClient *mocks.EVMQueryClient, height int64, res *evmtypes.QueryAccountRangeResponse) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryAccountRangeRequest")).
+		Return(res, nil)
+}
+
+// StorageRangeAt
+func RegisterStorageRangeAt(queryClient *mocks.EVMQueryClient, height int64, res *evmtypes.QueryStorageRangeAtResponse) {
+	queryClient.On("StorageRangeAt", rpc.ContextWithHeight(height), mock.AnythingOfType("*types.QueryStorageRangeAtRequest")).
+		Return(res, nil)
+}
+
 // TraceBlock
 func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
@@ -137,16 +168,35 @@ func TestRegisterParamsError(t *testing.T) {
 	require.Error(t, err)
 }
 
+// heightContext matches a context carrying the gRPC block height header of the
+// given height, such as the cancelable contexts derived from the one returned by
+// rpc.ContextWithHeight.
+func heightContext(height int64) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		return ok && slices.Equal(md.Get(grpctypes.GRPCBlockHeightHeader), []string{strconv.FormatInt(height, 10)})
+	})
+}
+
 // ETH Call
 func RegisterEthCall(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
-	queryClient.On("EthCall", ctx, request).
+	queryClient.On("EthCall", heightContext(1), request).
 		Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 }
 
 func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
-	queryClient.On("EthCall", ctx, request).
+	queryClient.On("EthCall", heightContext(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// Create Access List
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, res *evmtypes.QueryCreateAccessListResponse) {
+	queryClient.On("CreateAccessList", heightContext(1), request).
+		Return(res, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", heightContext(1), request).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
@@ -0,0 +1,357 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/evmos/os/rpc/types"
+	"github.com/evmos/os/server/config"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+const (
+	// FeeOracleSubscriber is the name of the fee oracle subscription to the
+	// new block events.
+	FeeOracleSubscriber = "FeeOracle"
+
+	// feeOracleSampleNumber is the number of the lowest tips of each block
+	// sampled to suggest the gas tip cap.
+	feeOracleSampleNumber = 3
+)
+
+// blockFees holds the fee data of a block, as used by eth_feeHistory and the
+// gas tip cap suggestion.
+type blockFees struct {
+	// baseFee is nil if the block is before London or the fee market is disabled
+	baseFee      *big.Int
+	nextBaseFee  *big.Int
+	gasUsed      uint64
+	gasUsedRatio float64
+	// txs are the effective tips of the Ethereum transactions of the block,
+	// along with their gas used, sorted in ascending tip order
+	txs sortGasAndReward
+}
+
+// rewards returns the effective tips at the given percentiles of the gas used
+// by the block, weighting every transaction tip by its gas used.
+func (f *blockFees) rewards(percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	if len(f.txs) == 0 {
+		// return an all zero row if there are no transactions to gather data from
+		for i := range rewards {
+			rewards[i] = big.NewInt(0)
+		}
+		return rewards
+	}
+
+	var txIndex int
+	sumGasUsed := f.txs[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(f.gasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(f.txs)-1 {
+			txIndex++
+			sumGasUsed += f.txs[txIndex].gasUsed
+		}
+		rewards[i] = f.txs[txIndex].reward
+	}
+	return rewards
+}
+
+// feeOracle keeps the fee data of a rolling window of the latest blocks in
+// memory, so that the fee related queries don't fetch the same blocks and
+// block results again and again.
+type feeOracle struct {
+	mtx    sync.RWMutex
+	blocks map[int64]*blockFees
+	latest int64
+	// window is the number of latest blocks kept in memory
+	window int64
+	// sampleBlocks is the number of latest blocks sampled to suggest the gas
+	// tip cap
+	sampleBlocks int64
+	// percentile is the percentile of the sampled tips suggested as gas tip cap
+	percentile int64
+	// live is true once the oracle follows the new blocks, before that the
+	// latest cached block may not be the latest block of the chain
+	live bool
+}
+
+// newFeeOracle returns an empty fee oracle keeping the given number of blocks.
+func newFeeOracle(window, sampleBlocks, percentile int64) *feeOracle {
+	if window < sampleBlocks {
+		window = sampleBlocks
+	}
+	return &feeOracle{
+		blocks:       make(map[int64]*blockFees),
+		window:       window,
+		sampleBlocks: sampleBlocks,
+		percentile:   percentile,
+	}
+}
+
+// feeOracles holds the fee oracle of every server context.
+var feeOracles sync.Map
+
+// sharedFeeOracle returns the fee oracle of the given server context, so that
+// the backend following the new blocks and the backends serving the fee
+// queries share the same cache.
+func sharedFeeOracle(ctx *server.Context, cfg config.JSONRPCConfig) *feeOracle {
+	oracle := newFeeOracle(
+		int64(cfg.FeeHistoryCap),
+		int64(cfg.GasPriceOracleBlocks),
+		int64(cfg.GasPriceOraclePercentile),
+	)
+	shared, _ := feeOracles.LoadOrStore(ctx, oracle)
+	return shared.(*feeOracle)
+}
+
+// get returns the cached fee data of the block at the given height.
+func (o *feeOracle) get(height int64) (*blockFees, bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	fees, ok := o.blocks[height]
+	return fees, ok
+}
+
+// add caches the fee data of the block at the given height, unless it is
+// older than the window of kept blocks. The blocks falling out of the window
+// are evicted.
+func (o *feeOracle) add(height int64, fees *blockFees) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	if height <= o.latest-o.window {
+		return
+	}
+	o.blocks[height] = fees
+	if height <= o.latest {
+		return
+	}
+
+	o.latest = height
+	for h := range o.blocks {
+		if h <= o.latest-o.window {
+			delete(o.blocks, h)
+		}
+	}
+}
+
+// setLive marks whether the oracle follows the new blocks.
+func (o *feeOracle) setLive(live bool) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.live = live
+}
+
+// latestFees returns the fee data of the latest block, if the oracle follows
+// the new blocks and has cached it.
+func (o *feeOracle) latestFees() (*blockFees, bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	if !o.live {
+		return nil, false
+	}
+	fees, ok := o.blocks[o.latest]
+	return fees, ok
+}
+
+// suggestTipCap returns the configured percentile of the lowest tips of the
+// latest cached blocks. It returns false if there is no tip to sample, either
+// because the oracle doesn't follow the new blocks or because the latest blocks
+// have no transactions.
+func (o *feeOracle) suggestTipCap() (*big.Int, bool) {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	if !o.live {
+		return nil, false
+	}
+
+	var samples []*big.Int
+	for h := o.latest; h > o.latest-o.sampleBlocks; h-- {
+		fees, ok := o.blocks[h]
+		if !ok {
+			continue
+		}
+		for i := 0; i < len(fees.txs) && i < feeOracleSampleNumber; i++ {
+			samples = append(samples, fees.txs[i].reward)
+		}
+	}
+	if len(samples) == 0 {
+		return nil, false
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+	return new(big.Int).Set(samples[(len(samples)-1)*int(o.percentile)/100]), true
+}
+
+// StartFeeOracle subscribes to the new blocks and caches their fee data as
+// soon as they are committed, until the given context is done. The blocks
+// requested by the fee queries are cached too, as long as they fall in the
+// window of the latest blocks.
+func (b *Backend) StartFeeOracle(ctx context.Context) error {
+	client, ok := b.clientCtx.Client.(cmtrpcclient.EventsClient)
+	if !ok {
+		return fmt.Errorf("invalid rpc client, expected: cmtrpcclient.EventsClient, got: %T", b.clientCtx.Client)
+	}
+
+	// Use an unbuffered subscription so that it doesn't get canceled when the
+	// blocks are not processed fast enough, the headers are only used as a
+	// signal and the processing happens in a separate goroutine.
+	query := cmttypes.QueryForEvent(cmttypes.EventNewBlockHeader).String()
+	headers, err := client.Subscribe(ctx, FeeOracleSubscriber, query, 0)
+	if err != nil {
+		return err
+	}
+
+	var latest atomic.Int64
+	newBlockSignal := make(chan struct{}, 1)
+	go func() {
+		defer close(newBlockSignal)
+		for {
+			select {
+			case <-ctx.Done():
+				// the fee queries fall back to fetching the blocks on demand
+				b.feeOracle.setLive(false)
+				if err := client.Unsubscribe(context.Background(), FeeOracleSubscriber, query); err != nil {
+					b.logger.Debug("failed to unsubscribe the fee oracle", "error", err.Error())
+				}
+				return
+			case msg, ok := <-headers:
+				if !ok {
+					b.feeOracle.setLive(false)
+					return
+				}
+				data, ok := msg.Data.(cmttypes.EventDataNewBlockHeader)
+				if !ok {
+					continue
+				}
+				latest.Store(data.Header.Height)
+				select {
+				case newBlockSignal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	go func() {
+		// the blocks skipped while processing are fetched on demand
+		for range newBlockSignal {
+			height := latest.Load()
+			if _, err := b.blockFees(height); err != nil {
+				b.logger.Debug("failed to cache block fees", "height", height, "error", err.Error())
+			}
+		}
+	}()
+
+	b.feeOracle.setLive(true)
+	return nil
+}
+
+// blockFees returns the fee data of the block at the given height, from the
+// fee oracle cache if possible.
+func (b *Backend) blockFees(height int64) (*blockFees, error) {
+	if fees, ok := b.feeOracle.get(height); ok {
+		return fees, nil
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for height %d", height)
+	}
+
+	blockRes, err := b.rpcClient.BlockResults(b.ctx, &height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch block result for height %d", height)
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(rpctypes.ContextWithHeight(height), b.clientCtx, height)
+	if err != nil {
+		b.logger.Error("failed to query consensus params", "error", err.Error())
+	}
+	if gasLimit <= 0 {
+		return nil, fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", height, gasLimit)
+	}
+
+	fees := &blockFees{
+		baseFee:     baseFee,
+		nextBaseFee: new(big.Int),
+		gasUsed:     blockGasUsed(blockRes),
+	}
+	fees.gasUsedRatio = float64(fees.gasUsed) / float64(gasLimit)
+
+	cfg := b.ChainConfig()
+	if baseFee != nil && cfg.IsLondon(big.NewInt(height+1)) {
+		fees.nextBaseFee = misc.CalcBaseFee(cfg, &ethtypes.Header{
+			Number:   big.NewInt(height),
+			GasLimit: uint64(gasLimit),
+			GasUsed:  fees.gasUsed,
+			BaseFee:  baseFee,
+		})
+	}
+
+	txDecoder := b.clientCtx.TxConfig.TxDecoder()
+	for i, txBz := range resBlock.Block.Txs {
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+		txGasUsed := uint64(blockRes.TxsResults[i].GasUsed) // #nosec G115
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			reward := ethMsg.AsTransaction().EffectiveGasTipValue(baseFee)
+			if reward == nil || reward.Sign() < 0 {
+				reward = big.NewInt(0)
+			}
+			fees.txs = append(fees.txs, txGasAndReward{gasUsed: txGasUsed, reward: reward})
+		}
+	}
+	sort.Sort(fees.txs)
+
+	b.feeOracle.add(height, fees)
+	return fees, nil
+}
+
+// LatestBaseFee returns the base fee of the latest block, from the fee oracle
+// cache if possible.
+func (b *Backend) LatestBaseFee() (*big.Int, error) {
+	if fees, ok := b.feeOracle.latestFees(); ok {
+		return fees.baseFee, nil
+	}
+
+	head, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+	return head.BaseFee, nil
+}
@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/evmos/os/rpc/backend/mocks"
+	"github.com/evmos/os/server/config"
+	"github.com/stretchr/testify/mock"
+)
+
+func (suite *BackendTestSuite) TestFeeOracleWindow() {
+	oracle := newFeeOracle(2, 1, 50)
+	for h := int64(1); h <= 3; h++ {
+		oracle.add(h, &blockFees{})
+	}
+
+	_, ok := oracle.get(1)
+	suite.Require().False(ok, "block out of the window should be evicted")
+	for _, h := range []int64{2, 3} {
+		_, ok := oracle.get(h)
+		suite.Require().True(ok)
+	}
+
+	// blocks older than the window are not cached
+	oracle.add(1, &blockFees{})
+	_, ok = oracle.get(1)
+	suite.Require().False(ok)
+
+	// the latest block is only served once the oracle follows the new blocks
+	_, ok = oracle.latestFees()
+	suite.Require().False(ok)
+	oracle.setLive(true)
+	_, ok = oracle.latestFees()
+	suite.Require().True(ok)
+}
+
+func (suite *BackendTestSuite) TestFeeOracleSuggestTipCap() {
+	txs := func(rewards ...int64) sortGasAndReward {
+		res := make(sortGasAndReward, 0, len(rewards))
+		for _, r := range rewards {
+			res = append(res, txGasAndReward{gasUsed: 21000, reward: big.NewInt(r)})
+		}
+		return res
+	}
+
+	oracle := newFeeOracle(10, 2, 50)
+	oracle.add(1, &blockFees{txs: txs(1000)})
+	oracle.add(2, &blockFees{txs: txs(1, 2, 3, 4)})
+	oracle.add(3, &blockFees{txs: txs(5, 6)})
+
+	_, ok := oracle.suggestTipCap()
+	suite.Require().False(ok, "oracle is not live")
+
+	oracle.setLive(true)
+	tip, ok := oracle.suggestTipCap()
+	suite.Require().True(ok)
+	// samples of the two latest blocks: 1, 2, 3, 5, 6
+	suite.Require().Equal(big.NewInt(3), tip)
+
+	oracle.add(4, &blockFees{})
+	oracle.add(5, &blockFees{})
+	_, ok = oracle.suggestTipCap()
+	suite.Require().False(ok, "no transactions in the sampled blocks")
+}
+
+func (suite *BackendTestSuite) TestSharedFeeOracle() {
+	ctx := server.NewDefaultContext()
+	cfg := config.DefaultJSONRPCConfig()
+
+	oracle := sharedFeeOracle(ctx, *cfg)
+	suite.Require().Same(oracle, sharedFeeOracle(ctx, *cfg))
+	suite.Require().NotSame(oracle, sharedFeeOracle(server.NewDefaultContext(), *cfg))
+}
+
+func (suite *BackendTestSuite) TestStartFeeOracle() {
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	query := cmttypes.QueryForEvent(cmttypes.EventNewBlockHeader).String()
+	headers := make(chan coretypes.ResultEvent)
+	client.On("Subscribe", mock.Anything, FeeOracleSubscriber, query, 0).
+		Return((<-chan coretypes.ResultEvent)(headers), nil)
+	unsubscribed := make(chan struct{})
+	client.On("Unsubscribe", mock.Anything, FeeOracleSubscriber, query).
+		Run(func(mock.Arguments) { close(unsubscribed) }).
+		Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.Require().NoError(suite.backend.StartFeeOracle(ctx))
+	suite.Require().True(suite.backend.feeOracle.live)
+
+	// the subscription is canceled once the oracle is stopped
+	cancel()
+	select {
+	case <-unsubscribed:
+	case <-time.After(5 * time.Second):
+		suite.FailNow("fee oracle was not unsubscribed")
+	}
+	_, ok := suite.backend.feeOracle.suggestTipCap()
+	suite.Require().False(ok, "oracle no longer follows the new blocks")
+}
+
+func (suite *BackendTestSuite) TestBlockFeesRewards() {
+	fees := &blockFees{
+		gasUsed: 100,
+		txs: sortGasAndReward{
+			{gasUsed: 10, reward: big.NewInt(1)},
+			{gasUsed: 40, reward: big.NewInt(2)},
+			{gasUsed: 50, reward: big.NewInt(3)},
+		},
+	}
+	suite.Require().Equal(
+		[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(3)},
+		fees.rewards([]float64{0, 50, 51, 100}),
+	)
+
+	empty := &blockFees{}
+	suite.Require().Equal([]*big.Int{big.NewInt(0), big.NewInt(0)}, empty.rewards([]float64{10, 90}))
+}
+
+func (suite *BackendTestSuite) TestFeeHistoryInvalidPercentiles() {
+	for _, percentiles := range [][]float64{{-1}, {101}, {50, 50}, {75, 25}} {
+		_, err := suite.backend.FeeHistory(1, ethrpc.BlockNumber(1), percentiles)
+		suite.Require().Error(err, percentiles)
+	}
+}
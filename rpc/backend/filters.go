@@ -3,8 +3,13 @@
 package backend
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	evmostypes "github.com/evmos/os/types"
 	"github.com/pkg/errors"
 )
 
@@ -34,5 +39,79 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
-	return 4096, 0
+	bloomIdxr, ok := b.indexer.(evmostypes.BloomIndexer)
+	if !ok {
+		return params.BloomBitsBlocks, 0
+	}
+
+	sections, err := bloomIdxr.BloomSections()
+	if err != nil {
+		b.logger.Debug("failed to fetch bloom sections", "error", err.Error())
+		return bloomIdxr.BloomSectionSize(), 0
+	}
+	return bloomIdxr.BloomSectionSize(), sections
+}
+
+// BloomBits returns the bit vector of a bloom bit in an indexed section.
+func (b *Backend) BloomBits(bit uint, section uint64) ([]byte, error) {
+	bloomIdxr, ok := b.indexer.(evmostypes.BloomIndexer)
+	if !ok {
+		return nil, errors.New("bloom bits indexer is not enabled")
+	}
+	return bloomIdxr.GetBloomBits(bit, section)
+}
+
+// LogTopicHeights returns the heights of the blocks within the [from, to] range
+// with logs emitted by any of the addresses with any of the first topics, using
+// the address index of the EVM tx indexer. The blocks after the returned height
+// are not indexed yet, so they have to be checked by the caller. It fails if the
+// range starts before the first block of the address index, which is after the
+// first indexed block if the address index was enabled on an existing db.
+func (b *Backend) LogTopicHeights(addresses []common.Address, topics0 []common.Hash, from, to int64) ([]int64, int64, error) {
+	addrIdxr, ok := b.indexer.(evmostypes.AddressIndexer)
+	if !ok {
+		return nil, 0, errors.New("address index is not enabled")
+	}
+
+	first, err := addrIdxr.FirstAddressIndexedBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+	if first == -1 {
+		return nil, 0, errors.New("address index is empty")
+	}
+	last, err := b.indexer.LastIndexedBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the blocks out of the retention window are pruned from the address index
+	if retention := int64(b.cfg.JSONRPC.AddressIndexRetention); retention > 0 { //nolint:gosec // G115 // retention won't exceed int64
+		first = max(first, last-retention+1)
+	}
+	if from < first {
+		return nil, 0, fmt.Errorf("block %d is not in the address index, the first block is %d", from, first)
+	}
+	indexedTo := min(to, last)
+
+	heights := make([]int64, 0)
+	for _, addr := range addresses {
+		for _, topic0 := range topics0 {
+			hashes, err := addrIdxr.GetByLogTopic(addr, topic0, from, indexedTo)
+			if err != nil {
+				return nil, 0, err
+			}
+			for _, hash := range hashes {
+				res, err := b.indexer.GetByTxHash(hash)
+				if err != nil {
+					return nil, 0, err
+				}
+				if res != nil && !slices.Contains(heights, res.Height) {
+					heights = append(heights, res.Height)
+				}
+			}
+		}
+	}
+	slices.Sort(heights)
+	return heights, indexedTo, nil
 }
@@ -3,9 +3,13 @@ package backend
 import (
 	"encoding/json"
 
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/os/indexer"
 	"github.com/evmos/os/rpc/backend/mocks"
 	ethrpc "github.com/evmos/os/rpc/types"
 	evmtypes "github.com/evmos/os/x/evm/types"
@@ -120,3 +124,90 @@ func (suite *BackendTestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestLogTopicHeights() {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	topicA := common.HexToHash("0x0a")
+	topicB := common.HexToHash("0x0b")
+
+	// indexBlocks indexes a block with a single tx emitting a log with the
+	// given first topic at every height from the given one
+	indexBlocks := func(idxer *indexer.KVIndexer, from int64, topics ...common.Hash) {
+		for i, topic := range topics {
+			height := from + int64(i)
+			msgEthereumTx, _ := suite.buildEthereumTx()
+			txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+			txHash := msgEthereumTx.AsTransaction().Hash()
+
+			txLog, err := json.Marshal(evmtypes.NewLogFromEth(&ethtypes.Log{
+				Address: contract,
+				Topics:  []common.Hash{topic},
+				TxHash:  txHash,
+			}))
+			suite.Require().NoError(err)
+
+			block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+			suite.Require().NoError(idxer.IndexBlock(block, []*abci.ExecTxResult{{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: contract.Hex()},
+					}},
+					{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+						{Key: evmtypes.AttributeKeyTxLog, Value: string(txLog)},
+					}},
+				},
+			}}))
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		retention    uint64
+		addressIndex bool
+		// number of blocks indexed before the address index is enabled
+		unindexed    int
+		from, to     int64
+		expHeights   []int64
+		expIndexedTo int64
+		expPass      bool
+	}{
+		{"pass - indexed range", 0, true, 0, 1, 3, []int64{1, 3}, 3, true},
+		{"pass - range after the last indexed block", 0, true, 0, 2, 10, []int64{3, 4}, 4, true},
+		{"pass - range within the retention window", 2, true, 0, 3, 4, []int64{3, 4}, 4, true},
+		{"pass - range after the address index was enabled", 0, true, 2, 3, 4, []int64{3, 4}, 4, true},
+		{"fail - range out of the retention window", 2, true, 0, 1, 4, nil, 0, false},
+		{"fail - range before the address index was enabled", 0, true, 2, 1, 4, nil, 0, false},
+		{"fail - address index is not enabled", 0, false, 0, 1, 4, nil, 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			topics := []common.Hash{topicA, topicB, topicA, topicA}
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), suite.backend.clientCtx)
+			indexBlocks(idxer, 1, topics[:tc.unindexed]...)
+			if tc.addressIndex {
+				idxer = idxer.WithAddressIndex(tc.retention)
+			}
+			indexBlocks(idxer, int64(tc.unindexed)+1, topics[tc.unindexed:]...)
+			suite.backend.indexer = idxer
+			suite.backend.cfg.JSONRPC.AddressIndexRetention = tc.retention
+
+			heights, indexedTo, err := suite.backend.LogTopicHeights([]common.Address{contract}, []common.Hash{topicA}, tc.from, tc.to)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expHeights, heights)
+			suite.Require().Equal(tc.expIndexedTo, indexedTo)
+		})
+	}
+}
@@ -303,12 +303,24 @@ func (b Backend) UnprotectedAllowed() bool {
 
 // RPCGasCap is the global gas cap for eth-call variants.
 func (b *Backend) RPCGasCap() uint64 {
-	return b.cfg.JSONRPC.GasCap
+	return b.runtimeCfg.gasCap.Load()
 }
 
 // RPCEVMTimeout is the global evm timeout for eth-call variants.
 func (b *Backend) RPCEVMTimeout() time.Duration {
-	return b.cfg.JSONRPC.EVMTimeout
+	return time.Duration(b.runtimeCfg.evmTimeout.Load())
+}
+
+// SetRPCGasCap sets the global gas cap for eth-call variants, for the backends
+// of all the namespaces.
+func (b *Backend) SetRPCGasCap(gasCap uint64) {
+	b.runtimeCfg.gasCap.Store(gasCap)
+}
+
+// SetRPCEVMTimeout sets the global evm timeout for eth-call variants, for the
+// backends of all the namespaces.
+func (b *Backend) SetRPCEVMTimeout(timeout time.Duration) {
+	b.runtimeCfg.evmTimeout.Store(int64(timeout))
 }
 
 // RPCGasCap is the global gas cap for eth-call variants.
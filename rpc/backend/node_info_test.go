@@ -3,6 +3,7 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/evmos/os/server/config"
 	"github.com/evmos/os/testutil/constants"
@@ -10,6 +11,7 @@ import (
 
 	"cosmossdk.io/math"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -359,3 +361,22 @@ func (suite *BackendTestSuite) TestImportRawKey() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestSharedRuntimeConfig() {
+	ctx := server.NewDefaultContext()
+	cfg := config.DefaultJSONRPCConfig()
+
+	// the backends of the same server context share the runtime config
+	first := &Backend{runtimeCfg: sharedRuntimeConfig(ctx, *cfg)}
+	second := &Backend{runtimeCfg: sharedRuntimeConfig(ctx, config.JSONRPCConfig{})}
+	suite.Require().Equal(cfg.GasCap, second.RPCGasCap())
+	suite.Require().Equal(cfg.EVMTimeout, second.RPCEVMTimeout())
+
+	first.SetRPCGasCap(100)
+	first.SetRPCEVMTimeout(time.Second)
+	suite.Require().Equal(uint64(100), second.RPCGasCap())
+	suite.Require().Equal(time.Second, second.RPCEVMTimeout())
+
+	other := &Backend{runtimeCfg: sharedRuntimeConfig(server.NewDefaultContext(), *cfg)}
+	suite.Require().Equal(cfg.GasCap, other.RPCGasCap())
+}
@@ -0,0 +1,578 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/os/rpc/types"
+	"github.com/evmos/os/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+// callTracer is the native tracer returning the nested call frames of a
+// transaction.
+const callTracer = "callTracer"
+
+// otsCallFrame is a call frame as returned by the callTracer.
+type otsCallFrame struct {
+	Type   string         `json:"type"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+	Error  string         `json:"error"`
+	Calls  []otsCallFrame `json:"calls"`
+}
+
+// OtsInternalOperations returns the value transfers, contract creations and
+// self destructs performed by the contracts called by the given transaction.
+func (b *Backend) OtsInternalOperations(hash common.Hash) ([]*rpctypes.OtsInternalOperation, error) {
+	frame, err := b.otsCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	return otsInternalOperations(frame.Calls, []*rpctypes.OtsInternalOperation{}), nil
+}
+
+// OtsTraceTransaction returns the call frames of the given transaction in
+// execution order.
+func (b *Backend) OtsTraceTransaction(hash common.Hash) ([]*rpctypes.OtsTraceEntry, error) {
+	frame, err := b.otsCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	return otsTraceEntries(frame, 0, []*rpctypes.OtsTraceEntry{}), nil
+}
+
+// OtsTransactionError returns the revert data of the given transaction, or
+// empty bytes if it did not fail.
+func (b *Backend) OtsTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	frame, err := b.otsCallFrame(hash)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" || frame.Output == nil {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// OtsSearchTransactionsBefore returns a page of the transactions sent from,
+// sent to or creating the given address before the given block, the latest
+// ones first. A block number of 0 returns the most recent transactions.
+func (b *Backend) OtsSearchTransactionsBefore(
+	address common.Address,
+	blockNum uint64,
+	pageSize int,
+) (*rpctypes.OtsTransactionsWithReceipts, error) {
+	from, to, err := b.otsSearchRange()
+	if err != nil {
+		return nil, err
+	}
+	if blockNum > 0 {
+		to = int64(blockNum) - 1 //#nosec G115 -- int overflow is not a concern here
+	}
+
+	hashes, hasMore, err := b.searchAddressTxs(address, from, to, pageSize, false)
+	if err != nil {
+		return nil, err
+	}
+	return b.otsTransactionsWithReceipts(hashes, blockNum == 0, !hasMore)
+}
+
+// OtsSearchTransactionsAfter returns a page of the transactions sent from,
+// sent to or creating the given address after the given block, the latest
+// ones first. A block number of 0 returns the oldest transactions.
+func (b *Backend) OtsSearchTransactionsAfter(
+	address common.Address,
+	blockNum uint64,
+	pageSize int,
+) (*rpctypes.OtsTransactionsWithReceipts, error) {
+	from, to, err := b.otsSearchRange()
+	if err != nil {
+		return nil, err
+	}
+	if blockNum > 0 {
+		from = int64(blockNum) + 1 //#nosec G115 -- int overflow is not a concern here
+	}
+
+	hashes, hasMore, err := b.searchAddressTxs(address, from, to, pageSize, true)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+		hashes[i], hashes[j] = hashes[j], hashes[i]
+	}
+	return b.otsTransactionsWithReceipts(hashes, !hasMore, blockNum == 0)
+}
+
+// OtsContractCreator returns the transaction and the address that created the
+// given contract. It returns nil if the address holds no code or if the
+// contract was not created by a transaction, e.g. at genesis.
+func (b *Backend) OtsContractCreator(address common.Address) (*rpctypes.OtsContractCreator, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	hasCode := func(height int64) (bool, error) {
+		blockNum := rpctypes.BlockNumber(height)
+		code, err := b.GetCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+		if err != nil {
+			return false, err
+		}
+		return len(code) > 0, nil
+	}
+
+	if ok, err := hasCode(int64(latest)); err != nil || !ok { //#nosec G115 -- int overflow is not a concern here
+		return nil, err
+	}
+
+	// the code is never removed before the latest block, so the creation
+	// block is the first one holding the code
+	height, err := searchFirstHeight(1, int64(latest), hasCode) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+
+	frames, err := b.FlatTraceBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	for _, frame := range frames {
+		creator, err := matchCreateTrace(frame, address)
+		if err != nil {
+			return nil, err
+		}
+		if creator != nil {
+			return creator, nil
+		}
+	}
+	return nil, nil
+}
+
+// OtsTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the given address with the given nonce. It returns nil if the nonce has not
+// been used yet, or if it was used by a Cosmos transaction.
+func (b *Backend) OtsTransactionBySenderAndNonce(address common.Address, nonce uint64) (*common.Hash, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	nonceUsed := func(height int64) (bool, error) {
+		count, err := b.GetTransactionCount(address, rpctypes.BlockNumber(height))
+		if err != nil {
+			return false, err
+		}
+		return uint64(*count) > nonce, nil
+	}
+
+	if ok, err := nonceUsed(int64(latest)); err != nil || !ok { //#nosec G115 -- int overflow is not a concern here
+		return nil, err
+	}
+
+	height, err := searchFirstHeight(1, int64(latest), nonceUsed) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	blockRes, err := b.rpcClient.BlockResults(b.ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range b.EthMsgsFromTendermintBlock(block, blockRes) {
+		sender, err := msg.GetSender(b.chainID)
+		if err != nil {
+			continue
+		}
+		tx := msg.AsTransaction()
+		if sender == address && tx.Nonce() == nonce {
+			hash := tx.Hash()
+			return &hash, nil
+		}
+	}
+	return nil, nil
+}
+
+// OtsBlockDetails returns the header of the given block along with its
+// transaction count and the fees paid by its transactions.
+func (b *Backend) OtsBlockDetails(blockNum rpctypes.BlockNumber) (*rpctypes.OtsBlockDetails, error) {
+	block, err := b.GetBlockByNumber(blockNum, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	receipts, err := b.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil {
+		return nil, err
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	totalFees := otsTotalFees(txs, receipts)
+
+	details := make(map[string]interface{}, len(block))
+	for key, value := range block {
+		details[key] = value
+	}
+	delete(details, "transactions")
+	details["transactionCount"] = len(txs)
+	details["logsBloom"] = nil
+
+	return &rpctypes.OtsBlockDetails{
+		Block:     details,
+		TotalFees: hexutil.Big(*totalFees),
+	}, nil
+}
+
+// OtsBlockTransactions returns a page of the transactions of the given block
+// with their receipts. The pages are counted from the last transaction of the
+// block, and the input of the transactions is truncated to the method selector.
+func (b *Backend) OtsBlockTransactions(
+	blockNum rpctypes.BlockNumber,
+	pageNumber, pageSize int,
+) (*rpctypes.OtsBlockTransactions, error) {
+	block, err := b.GetBlockByNumber(blockNum, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	receipts, err := b.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil {
+		return nil, err
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	if len(txs) != len(receipts) {
+		return nil, fmt.Errorf("found %d receipts for %d transactions in block %d", len(receipts), len(txs), blockNum)
+	}
+
+	// pages are counted from the end of the block
+	end := len(txs) - pageNumber*pageSize
+	if end < 0 {
+		end = 0
+	}
+	start := end - pageSize
+	if start < 0 {
+		start = 0
+	}
+
+	pageTxs := make([]interface{}, 0, end-start)
+	pageReceipts := make([]map[string]interface{}, 0, end-start)
+	for i := start; i < end; i++ {
+		if tx, ok := txs[i].(*rpctypes.RPCTransaction); ok && len(tx.Input) > 4 {
+			tx.Input = tx.Input[:4]
+		}
+		pageTxs = append(pageTxs, txs[i])
+
+		receipt := receipts[i]
+		receipt["logs"] = nil
+		receipt["logsBloom"] = nil
+		pageReceipts = append(pageReceipts, receipt)
+	}
+
+	fullBlock := make(map[string]interface{}, len(block))
+	for key, value := range block {
+		fullBlock[key] = value
+	}
+	fullBlock["transactions"] = pageTxs
+	fullBlock["transactionCount"] = len(txs)
+	fullBlock["logsBloom"] = nil
+
+	return &rpctypes.OtsBlockTransactions{
+		FullBlock: fullBlock,
+		Receipts:  pageReceipts,
+	}, nil
+}
+
+// otsCallFrame returns the top-level call frame of the given transaction,
+// traced with the callTracer.
+func (b *Backend) otsCallFrame(hash common.Hash) (*otsCallFrame, error) {
+	result, err := b.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	var frame otsCallFrame
+	if err := decodeTraceResult(result, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// otsSearchRange returns the range of blocks covered by the address index of
+// the EVM tx indexer. The genesis block holds no transactions.
+func (b *Backend) otsSearchRange() (from, to int64, err error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if addrIdxr, ok := b.indexer.(types.AddressIndexer); ok {
+		if from, err = addrIdxr.FirstAddressIndexedBlock(); err != nil {
+			return 0, 0, err
+		}
+	}
+	if from < 1 {
+		from = 1
+	}
+	return from, int64(latest), nil //#nosec G115 -- int overflow is not a concern here
+}
+
+// searchAddressTxs returns the hashes of the transactions of the given address
+// within the block range, up to the page size, walking the range from its
+// start if ascending or from its end otherwise. The transactions of a block
+// are never split across pages, so a page may hold more transactions than its
+// size. It also returns whether there are more transactions in the range.
+func (b *Backend) searchAddressTxs(
+	address common.Address,
+	from, to int64,
+	pageSize int,
+	ascending bool,
+) ([]common.Hash, bool, error) {
+	addrIdxr, ok := b.indexer.(types.AddressIndexer)
+	if !ok {
+		return nil, false, errors.New("address index is not enabled")
+	}
+
+	window := int64(b.RPCBlockRangeCap())
+	if window < 1 {
+		window = 1
+	}
+
+	hashes := []common.Hash{}
+	lastHeight := int64(-1)
+	for from <= to {
+		start, end := from, to
+		if ascending && end-start >= window {
+			end = start + window - 1
+		} else if !ascending && end-start >= window {
+			start = end - window + 1
+		}
+
+		windowHashes, err := addrIdxr.GetByAddress(address, start, end)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ascending {
+			for i, j := 0, len(windowHashes)-1; i < j; i, j = i+1, j-1 {
+				windowHashes[i], windowHashes[j] = windowHashes[j], windowHashes[i]
+			}
+		}
+
+		for _, hash := range windowHashes {
+			res, err := b.GetTxByEthHash(hash)
+			if err != nil {
+				return nil, false, errorsmod.Wrapf(err, "failed to get transaction %s", hash.Hex())
+			}
+			if len(hashes) >= pageSize && res.Height != lastHeight {
+				return hashes, true, nil
+			}
+			hashes = append(hashes, hash)
+			lastHeight = res.Height
+		}
+
+		if ascending {
+			from = end + 1
+		} else {
+			to = start - 1
+		}
+	}
+	return hashes, false, nil
+}
+
+// otsTransactionsWithReceipts returns the transactions and receipts of the
+// given hashes. The receipts hold the timestamp of their block.
+func (b *Backend) otsTransactionsWithReceipts(
+	hashes []common.Hash,
+	firstPage, lastPage bool,
+) (*rpctypes.OtsTransactionsWithReceipts, error) {
+	res := &rpctypes.OtsTransactionsWithReceipts{
+		Txs:       make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts:  make([]map[string]interface{}, 0, len(hashes)),
+		FirstPage: firstPage,
+		LastPage:  lastPage,
+	}
+
+	timestamps := make(map[int64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get transaction %s", hash.Hex())
+		}
+		receipt, err := b.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get receipt %s", hash.Hex())
+		}
+		if tx == nil || receipt == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+		}
+
+		height := tx.BlockNumber.ToInt().Int64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if block == nil {
+				return nil, fmt.Errorf("block %d not found", height)
+			}
+			timestamp = hexutil.Uint64(block.Block.Time.Unix()) //#nosec G115 -- block times are never negative
+			timestamps[height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+// otsInternalOperations appends the internal operations of the given call
+// frames and of their sub calls to ops.
+func otsInternalOperations(frames []otsCallFrame, ops []*rpctypes.OtsInternalOperation) []*rpctypes.OtsInternalOperation {
+	for _, frame := range frames {
+		op := &rpctypes.OtsInternalOperation{From: frame.From, To: frame.To, Value: frame.Value}
+		if op.Value == nil {
+			op.Value = (*hexutil.Big)(new(big.Int))
+		}
+
+		switch frame.Type {
+		case "CALL":
+			if op.Value.ToInt().Sign() > 0 {
+				op.Type = rpctypes.OtsOpTransfer
+				ops = append(ops, op)
+			}
+		case "SELFDESTRUCT":
+			op.Type = rpctypes.OtsOpSelfDestruct
+			ops = append(ops, op)
+		case "CREATE":
+			op.Type = rpctypes.OtsOpCreate
+			ops = append(ops, op)
+		case "CREATE2":
+			op.Type = rpctypes.OtsOpCreate2
+			ops = append(ops, op)
+		}
+
+		ops = otsInternalOperations(frame.Calls, ops)
+	}
+	return ops
+}
+
+// otsTraceEntries appends the given call frame and its sub calls to entries,
+// in execution order.
+func otsTraceEntries(frame *otsCallFrame, depth int, entries []*rpctypes.OtsTraceEntry) []*rpctypes.OtsTraceEntry {
+	entry := &rpctypes.OtsTraceEntry{
+		Type:   frame.Type,
+		Depth:  depth,
+		From:   frame.From,
+		To:     frame.To,
+		Value:  frame.Value,
+		Input:  frame.Input,
+		Output: frame.Output,
+	}
+	// delegate and static calls cannot transfer value
+	if frame.Type == "DELEGATECALL" || frame.Type == "STATICCALL" {
+		entry.Value = nil
+	}
+	entries = append(entries, entry)
+
+	for i := range frame.Calls {
+		entries = otsTraceEntries(&frame.Calls[i], depth+1, entries)
+	}
+	return entries
+}
+
+// otsTotalFees returns the fees paid by the transactions of a block, given
+// their receipts in the same order.
+func otsTotalFees(txs []interface{}, receipts []map[string]interface{}) *big.Int {
+	totalFees := new(big.Int)
+	for i, receipt := range receipts {
+		if i >= len(txs) {
+			break
+		}
+		tx, ok := txs[i].(*rpctypes.RPCTransaction)
+		if !ok || tx.GasPrice == nil {
+			continue
+		}
+		gasUsed, ok := receipt["gasUsed"].(hexutil.Uint64)
+		if !ok {
+			continue
+		}
+		fee := new(big.Int).Mul(tx.GasPrice.ToInt(), new(big.Int).SetUint64(uint64(gasUsed)))
+		totalFees.Add(totalFees, fee)
+	}
+	return totalFees
+}
+
+// matchCreateTrace returns the creator of the given contract if the flat call
+// trace is its successful creation.
+func matchCreateTrace(frame json.RawMessage, address common.Address) (*rpctypes.OtsContractCreator, error) {
+	var trace struct {
+		Action struct {
+			From common.Address `json:"from"`
+		} `json:"action"`
+		Result *struct {
+			Address *common.Address `json:"address"`
+		} `json:"result"`
+		TransactionHash *common.Hash `json:"transactionHash"`
+		Type            string       `json:"type"`
+	}
+	if err := json.Unmarshal(frame, &trace); err != nil {
+		return nil, err
+	}
+
+	if trace.Type != "create" || trace.Result == nil || trace.Result.Address == nil || *trace.Result.Address != address {
+		return nil, nil
+	}
+
+	creator := &rpctypes.OtsContractCreator{Creator: trace.Action.From}
+	if trace.TransactionHash != nil {
+		creator.Hash = *trace.TransactionHash
+	}
+	return creator, nil
+}
+
+// searchFirstHeight returns the first height of the range for which the
+// condition holds, given that it holds for the end of the range and keeps
+// holding once it does.
+func searchFirstHeight(from, to int64, cond func(int64) (bool, error)) (int64, error) {
+	for from < to {
+		mid := from + (to-from)/2
+		ok, err := cond(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			to = mid
+		} else {
+			from = mid + 1
+		}
+	}
+	return to, nil
+}
@@ -0,0 +1,133 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/os/rpc/types"
+)
+
+func (suite *BackendTestSuite) TestOtsCallFrames() {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	recipient := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	created := common.HexToAddress("0x1000000000000000000000000000000000000004")
+
+	result := map[string]interface{}{
+		"type":  "CALL",
+		"from":  sender.Hex(),
+		"to":    contract.Hex(),
+		"value": "0x1",
+		"input": "0x12345678",
+		"calls": []interface{}{
+			map[string]interface{}{"type": "CALL", "from": contract.Hex(), "to": recipient.Hex(), "value": "0x0"},
+			map[string]interface{}{"type": "STATICCALL", "from": contract.Hex(), "to": recipient.Hex()},
+			map[string]interface{}{
+				"type":  "CREATE2",
+				"from":  contract.Hex(),
+				"to":    created.Hex(),
+				"value": "0x2",
+				"calls": []interface{}{
+					map[string]interface{}{"type": "CALL", "from": created.Hex(), "to": recipient.Hex(), "value": "0x3"},
+					map[string]interface{}{"type": "SELFDESTRUCT", "from": created.Hex(), "to": sender.Hex(), "value": "0x4"},
+				},
+			},
+		},
+	}
+
+	var frame otsCallFrame
+	suite.Require().NoError(decodeTraceResult(result, &frame))
+
+	ops := otsInternalOperations(frame.Calls, []*rpctypes.OtsInternalOperation{})
+	suite.Require().Equal([]*rpctypes.OtsInternalOperation{
+		{Type: rpctypes.OtsOpCreate2, From: contract, To: created, Value: (*hexutil.Big)(big.NewInt(2))},
+		{Type: rpctypes.OtsOpTransfer, From: created, To: recipient, Value: (*hexutil.Big)(big.NewInt(3))},
+		{Type: rpctypes.OtsOpSelfDestruct, From: created, To: sender, Value: (*hexutil.Big)(big.NewInt(4))},
+	}, ops)
+
+	entries := otsTraceEntries(&frame, 0, []*rpctypes.OtsTraceEntry{})
+	suite.Require().Len(entries, 6)
+
+	expTypes := []string{"CALL", "CALL", "STATICCALL", "CREATE2", "CALL", "SELFDESTRUCT"}
+	expDepths := []int{0, 1, 1, 1, 2, 2}
+	for i, entry := range entries {
+		suite.Require().Equal(expTypes[i], entry.Type)
+		suite.Require().Equal(expDepths[i], entry.Depth)
+	}
+	suite.Require().Equal(hexutil.Bytes{0x12, 0x34, 0x56, 0x78}, entries[0].Input)
+	suite.Require().Nil(entries[2].Value)
+}
+
+func (suite *BackendTestSuite) TestOtsTotalFees() {
+	txs := []interface{}{
+		&rpctypes.RPCTransaction{GasPrice: (*hexutil.Big)(big.NewInt(10))},
+		&rpctypes.RPCTransaction{GasPrice: (*hexutil.Big)(big.NewInt(20))},
+	}
+	receipts := []map[string]interface{}{
+		{"gasUsed": hexutil.Uint64(21000)},
+		{"gasUsed": hexutil.Uint64(50000)},
+	}
+
+	suite.Require().Equal(big.NewInt(10*21000+20*50000), otsTotalFees(txs, receipts))
+	suite.Require().Equal(big.NewInt(0), otsTotalFees(nil, receipts))
+}
+
+func (suite *BackendTestSuite) TestMatchCreateTrace() {
+	creator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	created := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	hash := common.HexToHash("0x01")
+
+	create := json.RawMessage(fmt.Sprintf(
+		`{"action":{"from":"%s"},"result":{"address":"%s"},"transactionHash":"%s","type":"create"}`,
+		creator.Hex(), created.Hex(), hash.Hex(),
+	))
+	failedCreate := json.RawMessage(fmt.Sprintf(`{"action":{"from":"%s"},"error":"out of gas","type":"create"}`, creator.Hex()))
+	call := json.RawMessage(fmt.Sprintf(`{"action":{"from":"%s","to":"%s"},"type":"call"}`, creator.Hex(), created.Hex()))
+
+	testCases := []struct {
+		name       string
+		frame      json.RawMessage
+		address    common.Address
+		expCreator *rpctypes.OtsContractCreator
+	}{
+		{"contract creation", create, created, &rpctypes.OtsContractCreator{Hash: hash, Creator: creator}},
+		{"other contract creation", create, creator, nil},
+		{"failed contract creation", failedCreate, created, nil},
+		{"call", call, created, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			res, err := matchCreateTrace(tc.frame, tc.address)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCreator, res)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSearchFirstHeight() {
+	testCases := []struct {
+		name      string
+		from, to  int64
+		first     int64
+		expHeight int64
+	}{
+		{"single height", 1, 1, 1, 1},
+		{"first height", 1, 100, 1, 1},
+		{"last height", 1, 100, 100, 100},
+		{"middle height", 1, 100, 42, 42},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			height, err := searchFirstHeight(tc.from, tc.to, func(height int64) (bool, error) {
+				return height >= tc.first, nil
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expHeight, height)
+		})
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/os/rpc/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/pkg/errors"
+)
+
+// Native tracers producing the Parity trace formats.
+const (
+	flatCallTracer  = "flatCallTracer"
+	stateDiffTracer = "stateDiffTracer"
+	vmTraceTracer   = "vmTraceTracer"
+	muxTracer       = "muxTracer"
+)
+
+// FlatTraceBlock returns the Parity-style flat call traces of all the
+// transactions of the given block.
+func (b *Backend) FlatTraceBlock(block *tmrpctypes.ResultBlock) ([]json.RawMessage, error) {
+	config := &evmtypes.TraceConfig{Tracer: flatCallTracer}
+	results, err := b.TraceBlock(rpctypes.BlockNumber(block.Block.Height), config, block)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []json.RawMessage{}
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %d of block %d: %s", i, block.Block.Height, res.Error)
+		}
+		var frames []json.RawMessage
+		if err := decodeTraceResult(res.Result, &frames); err != nil {
+			return nil, err
+		}
+		traces = append(traces, frames...)
+	}
+	return traces, nil
+}
+
+// FlatTraceTransaction returns the Parity-style flat call traces of the given
+// transaction.
+func (b *Backend) FlatTraceTransaction(hash common.Hash) ([]json.RawMessage, error) {
+	result, err := b.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: flatCallTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	var frames []json.RawMessage
+	if err := decodeTraceResult(result, &frames); err != nil {
+		return nil, err
+	}
+	return frames, nil
+}
+
+// FilterFlatTraces returns the Parity-style flat call traces of the block
+// range matching the given filter. The block range is capped like the one of
+// the eth_getLogs queries.
+func (b *Backend) FilterFlatTraces(args rpctypes.TraceFilterArgs) ([]json.RawMessage, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := int64(latest), int64(latest) //#nosec G115 -- int overflow is not a concern here
+	if args.FromBlock != nil && *args.FromBlock >= rpctypes.EthEarliestBlockNumber {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= rpctypes.EthEarliestBlockNumber {
+		to = args.ToBlock.Int64()
+	}
+	if from < 1 {
+		// genesis is not traceable
+		from = 1
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if to-from > int64(b.RPCBlockRangeCap()) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", b.RPCBlockRangeCap())
+	}
+
+	var after, count uint64 = 0, math.MaxUint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	traces := []json.RawMessage{}
+	if count == 0 {
+		return traces, nil
+	}
+
+	for height := from; height <= to; height++ {
+		block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+
+		frames, err := b.FlatTraceBlock(block)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, frame := range frames {
+			matches, err := matchFlatTrace(frame, args.FromAddress, args.ToAddress)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, frame)
+			if uint64(len(traces)) == count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the outputs of the requested Parity trace types for each of them.
+func (b *Backend) ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	config, err := parityTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := b.TraceBlock(rpctypes.BlockNumber(block.Block.Height), config, block)
+	if err != nil {
+		return nil, err
+	}
+
+	// the traces follow the order of the Ethereum messages of the block
+	var hashes []common.Hash
+	txDecoder := b.clientCtx.TxConfig.TxDecoder()
+	for _, txBz := range block.Block.Txs {
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				hashes = append(hashes, ethMsg.AsTransaction().Hash())
+			}
+		}
+	}
+
+	replays := make([]*rpctypes.TraceResults, 0, len(results))
+	for i, res := range results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %d of block %d: %s", i, block.Block.Height, res.Error)
+		}
+		replay, err := newTraceResults(res.Result, traceTypes)
+		if err != nil {
+			return nil, err
+		}
+		if i < len(hashes) {
+			replay.TransactionHash = &hashes[i]
+		}
+		replays = append(replays, replay)
+	}
+	return replays, nil
+}
+
+// ReplayTransaction replays the given transaction and returns the outputs of
+// the requested Parity trace types.
+func (b *Backend) ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.TraceResults, error) {
+	config, err := parityTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := b.TraceTransaction(hash, config)
+	if err != nil {
+		return nil, err
+	}
+	return newTraceResults(result, traceTypes)
+}
+
+// ReplayCall executes the given call on top of the state of the requested
+// block and returns the outputs of the requested Parity trace types.
+func (b *Backend) ReplayCall(
+	args evmtypes.TransactionArgs,
+	traceTypes []string,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.TraceResults, error) {
+	config, err := parityTraceConfig(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := b.TraceCall(args, blockNrOrHash, &rpctypes.TraceCallConfig{TraceConfig: *config})
+	if err != nil {
+		return nil, err
+	}
+	return newTraceResults(result, traceTypes)
+}
+
+// parityTraceConfig returns the config of the mux tracer collecting the
+// outputs of the given Parity trace types. The flat call traces are always
+// collected as they hold the output of the execution.
+func parityTraceConfig(traceTypes []string) (*evmtypes.TraceConfig, error) {
+	if len(traceTypes) == 0 {
+		return nil, errors.New("no trace type requested")
+	}
+
+	tracers := map[string]json.RawMessage{flatCallTracer: json.RawMessage("{}")}
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case rpctypes.TraceTypeTrace:
+		case rpctypes.TraceTypeStateDiff:
+			tracers[stateDiffTracer] = json.RawMessage("{}")
+		case rpctypes.TraceTypeVMTrace:
+			tracers[vmTraceTracer] = json.RawMessage("{}")
+		default:
+			return nil, fmt.Errorf("invalid trace type: %s", traceType)
+		}
+	}
+
+	bz, err := json.Marshal(tracers)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.TraceConfig{Tracer: muxTracer, TracerJsonConfig: string(bz)}, nil
+}
+
+// newTraceResults builds the Parity trace results out of the result of the
+// mux tracer.
+func newTraceResults(result interface{}, traceTypes []string) (*rpctypes.TraceResults, error) {
+	var outputs struct {
+		FlatCallTracer  []json.RawMessage `json:"flatCallTracer"`
+		StateDiffTracer json.RawMessage   `json:"stateDiffTracer"`
+		VMTraceTracer   json.RawMessage   `json:"vmTraceTracer"`
+	}
+	if err := decodeTraceResult(result, &outputs); err != nil {
+		return nil, err
+	}
+
+	res := &rpctypes.TraceResults{
+		Trace:     []json.RawMessage{},
+		StateDiff: outputs.StateDiffTracer,
+		VMTrace:   outputs.VMTraceTracer,
+	}
+
+	// the output of the execution is the one of the top-level call
+	if len(outputs.FlatCallTracer) > 0 {
+		var top struct {
+			Result *struct {
+				Code   hexutil.Bytes `json:"code"`
+				Output hexutil.Bytes `json:"output"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(outputs.FlatCallTracer[0], &top); err != nil {
+			return nil, err
+		}
+		if top.Result != nil {
+			res.Output = top.Result.Output
+			if len(res.Output) == 0 {
+				res.Output = top.Result.Code
+			}
+		}
+	}
+
+	for _, traceType := range traceTypes {
+		if traceType == rpctypes.TraceTypeTrace {
+			res.Trace = outputs.FlatCallTracer
+		}
+	}
+	return res, nil
+}
+
+// matchFlatTrace returns whether the flat call trace is sent from one of the
+// from addresses and to one of the to addresses. An empty list of addresses
+// matches any trace.
+func matchFlatTrace(frame json.RawMessage, fromAddresses, toAddresses []common.Address) (bool, error) {
+	if len(fromAddresses) == 0 && len(toAddresses) == 0 {
+		return true, nil
+	}
+
+	var trace struct {
+		Action struct {
+			Address       *common.Address `json:"address"`
+			From          *common.Address `json:"from"`
+			RefundAddress *common.Address `json:"refundAddress"`
+			To            *common.Address `json:"to"`
+		} `json:"action"`
+		Result *struct {
+			Address *common.Address `json:"address"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(frame, &trace); err != nil {
+		return false, err
+	}
+
+	// self destructs are sent from the destroyed contract to the refund address
+	from := []*common.Address{trace.Action.From, trace.Action.Address}
+	to := []*common.Address{trace.Action.To, trace.Action.RefundAddress}
+	if trace.Result != nil {
+		to = append(to, trace.Result.Address)
+	}
+
+	return containsAddress(fromAddresses, from) && containsAddress(toAddresses, to), nil
+}
+
+// containsAddress returns whether any of the candidates is in the list of
+// addresses, or true if the list is empty.
+func containsAddress(addresses []common.Address, candidates []*common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, candidate := range candidates {
+		if candidate == nil {
+			continue
+		}
+		for _, addr := range addresses {
+			if addr == *candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// decodeTraceResult decodes the generic result of a tracer into the given
+// value.
+func decodeTraceResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
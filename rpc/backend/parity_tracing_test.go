@@ -0,0 +1,140 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/os/rpc/types"
+)
+
+func (suite *BackendTestSuite) TestParityTraceConfig() {
+	testCases := []struct {
+		name       string
+		traceTypes []string
+		expTracers []string
+		expPass    bool
+	}{
+		{"fail - no trace type", nil, nil, false},
+		{"fail - invalid trace type", []string{"trace", "invalid"}, nil, false},
+		{"pass - trace", []string{"trace"}, []string{flatCallTracer}, true},
+		{
+			"pass - all trace types",
+			[]string{"vmTrace", "trace", "stateDiff"},
+			[]string{flatCallTracer, stateDiffTracer, vmTraceTracer},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			config, err := parityTraceConfig(tc.traceTypes)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(muxTracer, config.Tracer)
+
+			var tracers map[string]json.RawMessage
+			suite.Require().NoError(json.Unmarshal([]byte(config.TracerJsonConfig), &tracers))
+			suite.Require().Len(tracers, len(tc.expTracers))
+			for _, tracer := range tc.expTracers {
+				suite.Require().Contains(tracers, tracer)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestNewTraceResults() {
+	frame := json.RawMessage(`{"action":{"callType":"call"},"result":{"gasUsed":"0x0","output":"0x01"},"type":"call"}`)
+	createFrame := json.RawMessage(`{"action":{"creationMethod":"create"},"result":{"code":"0x6001"},"type":"create"}`)
+
+	testCases := []struct {
+		name       string
+		result     interface{}
+		traceTypes []string
+		expOutput  hexutil.Bytes
+		expTrace   []json.RawMessage
+		expVM      bool
+	}{
+		{
+			"output without trace",
+			map[string]interface{}{"flatCallTracer": []json.RawMessage{frame}},
+			[]string{rpctypes.TraceTypeStateDiff},
+			hexutil.Bytes{0x01},
+			[]json.RawMessage{},
+			false,
+		},
+		{
+			"contract creation code",
+			map[string]interface{}{"flatCallTracer": []json.RawMessage{createFrame}},
+			[]string{rpctypes.TraceTypeTrace},
+			hexutil.Bytes{0x60, 0x01},
+			[]json.RawMessage{createFrame},
+			false,
+		},
+		{
+			"vm trace",
+			map[string]interface{}{
+				"flatCallTracer": []json.RawMessage{frame},
+				"vmTraceTracer":  map[string]interface{}{"code": "0x", "ops": []interface{}{}},
+			},
+			[]string{rpctypes.TraceTypeVMTrace},
+			hexutil.Bytes{0x01},
+			[]json.RawMessage{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			res, err := newTraceResults(tc.result, tc.traceTypes)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expOutput, res.Output)
+			suite.Require().Len(res.Trace, len(tc.expTrace))
+			for i := range tc.expTrace {
+				suite.Require().JSONEq(string(tc.expTrace[i]), string(res.Trace[i]))
+			}
+			suite.Require().Nil(res.StateDiff)
+			suite.Require().Equal(tc.expVM, res.VMTrace != nil)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestMatchFlatTrace() {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	created := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000004")
+
+	call := json.RawMessage(fmt.Sprintf(`{"action":{"from":"%s","to":"%s"},"type":"call"}`, from.Hex(), to.Hex()))
+	create := json.RawMessage(fmt.Sprintf(`{"action":{"from":"%s"},"result":{"address":"%s"},"type":"create"}`, from.Hex(), created.Hex()))
+	suicide := json.RawMessage(fmt.Sprintf(`{"action":{"address":"%s","refundAddress":"%s"},"type":"suicide"}`, created.Hex(), to.Hex()))
+
+	testCases := []struct {
+		name     string
+		frame    json.RawMessage
+		from     []common.Address
+		to       []common.Address
+		expMatch bool
+	}{
+		{"empty filter", call, nil, nil, true},
+		{"call sender", call, []common.Address{from}, nil, true},
+		{"call recipient", call, nil, []common.Address{to}, true},
+		{"call sender and recipient", call, []common.Address{from}, []common.Address{to}, true},
+		{"call other recipient", call, []common.Address{from}, []common.Address{other}, false},
+		{"created contract", create, nil, []common.Address{created}, true},
+		{"self destructed contract", suicide, []common.Address{created}, []common.Address{to}, true},
+		{"other sender", suicide, []common.Address{from}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			match, err := matchFlatTrace(tc.frame, tc.from, tc.to)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expMatch, match)
+		})
+	}
+}
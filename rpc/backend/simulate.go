@@ -0,0 +1,201 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/evmos/os/x/evm/core/vm"
+
+	rpctypes "github.com/evmos/os/rpc/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+// errCodeVMError is the JSON-RPC error code of the simulated calls failing
+// with an EVM error other than a revert.
+const errCodeVMError = -32015
+
+// SimulateV1 executes the calls of a sequence of simulated blocks on top of the
+// given block, and returns the simulated blocks along with the results of their
+// calls.
+func (b *Backend) SimulateV1(opts rpctypes.SimOpts, blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil || header == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.QuerySimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	parentHash := common.BytesToHash(header.Block.Hash())
+	blocks := make([]map[string]interface{}, 0, len(res.Blocks))
+	for i := range res.Blocks {
+		block, hash := b.formatSimulatedBlock(&res.Blocks[i], parentHash, opts.ReturnFullTransactions)
+		blocks = append(blocks, block)
+		parentHash = hash
+	}
+	return blocks, nil
+}
+
+// formatSimulatedBlock returns the JSON-RPC representation of a simulated
+// block and its hash. The hash is the one of the Ethereum header built out of
+// the simulated fields, there is no CometBFT block behind it.
+func (b *Backend) formatSimulatedBlock(
+	block *evmtypes.SimulatedBlock,
+	parentHash common.Hash,
+	fullTx bool,
+) (map[string]interface{}, common.Hash) {
+	txs := make(ethtypes.Transactions, 0, len(block.Txs))
+	for _, tx := range block.Txs {
+		txs = append(txs, tx.AsTransaction())
+	}
+
+	calls := make([]*rpctypes.SimCallResult, 0, len(block.Results))
+	var logs []*ethtypes.Log
+	for i := range block.Results {
+		call := newSimCallResult(&block.Results[i])
+		logs = append(logs, call.Logs...)
+		calls = append(calls, call)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash:  parentHash,
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Coinbase:    common.HexToAddress(block.FeeRecipient),
+		Root:        common.Hash{},
+		TxHash:      ethtypes.DeriveSha(txs, trie.NewStackTrie(nil)),
+		ReceiptHash: ethtypes.EmptyRootHash,
+		Bloom:       ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		Difficulty:  big.NewInt(0),
+		Number:      big.NewInt(block.Number),
+		GasLimit:    block.GasLimit,
+		GasUsed:     block.GasUsed,
+		Time:        block.Time,
+		BaseFee:     block.BaseFee.BigInt(),
+	}
+	hash := header.Hash()
+
+	for _, log := range logs {
+		log.BlockHash = hash
+		log.BlockNumber = uint64(block.Number) //#nosec G115 -- block numbers are never negative
+	}
+
+	transactions := make([]interface{}, 0, len(block.Txs))
+	for i, msg := range block.Txs {
+		if !fullTx {
+			transactions = append(transactions, common.HexToHash(msg.Hash))
+			continue
+		}
+		rpcTx, err := rpctypes.NewTransactionFromMsg(msg, hash, uint64(block.Number), uint64(i), header.BaseFee, b.chainID) //#nosec G115 -- block numbers are never negative
+		if err != nil {
+			b.logger.Debug("failed to format simulated transaction", "hash", msg.Hash, "error", err.Error())
+			continue
+		}
+		// the simulated transactions are not signed
+		rpcTx.From = common.HexToAddress(msg.From)
+		transactions = append(transactions, rpcTx)
+	}
+
+	return map[string]interface{}{
+		"number":           hexutil.Uint64(header.Number.Uint64()),
+		"hash":             hash,
+		"parentHash":       header.ParentHash,
+		"nonce":            ethtypes.BlockNonce{},
+		"sha3Uncles":       header.UncleHash,
+		"logsBloom":        header.Bloom,
+		"stateRoot":        header.Root,
+		"miner":            header.Coinbase,
+		"mixHash":          common.Hash{},
+		"difficulty":       (*hexutil.Big)(header.Difficulty),
+		"extraData":        "0x",
+		"size":             hexutil.Uint64(header.Size()),
+		"gasLimit":         hexutil.Uint64(header.GasLimit),
+		"gasUsed":          hexutil.Uint64(header.GasUsed),
+		"timestamp":        hexutil.Uint64(header.Time),
+		"transactionsRoot": header.TxHash,
+		"receiptsRoot":     header.ReceiptHash,
+		"uncles":           []common.Hash{},
+		"transactions":     transactions,
+		"totalDifficulty":  (*hexutil.Big)(big.NewInt(0)),
+		"baseFeePerGas":    (*hexutil.Big)(header.BaseFee),
+		"calls":            calls,
+	}, hash
+}
+
+// newSimCallResult returns the JSON-RPC representation of the result of a
+// simulated call. Reverted calls report the revert data along with the reason.
+func newSimCallResult(res *evmtypes.MsgEthereumTxResponse) *rpctypes.SimCallResult {
+	call := &rpctypes.SimCallResult{
+		ReturnData: res.Ret,
+		Logs:       evmtypes.LogsToEthereum(res.Logs),
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if call.ReturnData == nil {
+		call.ReturnData = hexutil.Bytes{}
+	}
+	if call.Logs == nil {
+		call.Logs = []*ethtypes.Log{}
+	}
+	if res.VmError == "" {
+		return call
+	}
+
+	call.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	// the logs of failed calls are reverted
+	call.Logs = []*ethtypes.Log{}
+	if res.VmError != vm.ErrExecutionReverted.Error() {
+		call.Error = &rpctypes.SimCallError{Code: errCodeVMError, Message: res.VmError}
+		return call
+	}
+
+	revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+	call.Error = &rpctypes.SimCallError{
+		Code:    revertErr.ErrorCode(),
+		Message: revertErr.Error(),
+		Data:    hexutil.Encode(res.Ret),
+	}
+	return call
+}
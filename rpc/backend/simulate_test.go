@@ -0,0 +1,63 @@
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/os/x/evm/core/vm"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestNewSimCallResult() {
+	log := &evmtypes.Log{Address: evmtypes.TransferLogAddress.Hex(), Data: []byte{0x1}}
+	revertData := []byte{0x1, 0x2}
+
+	testCases := []struct {
+		name      string
+		res       *evmtypes.MsgEthereumTxResponse
+		expStatus uint64
+		expLogs   int
+		expCode   int
+	}{
+		{
+			"successful call",
+			&evmtypes.MsgEthereumTxResponse{Ret: []byte{0x1}, Logs: []*evmtypes.Log{log}, GasUsed: 21000},
+			ethtypes.ReceiptStatusSuccessful,
+			1,
+			0,
+		},
+		{
+			"reverted call",
+			&evmtypes.MsgEthereumTxResponse{Ret: revertData, Logs: []*evmtypes.Log{log}, VmError: vm.ErrExecutionReverted.Error()},
+			ethtypes.ReceiptStatusFailed,
+			0,
+			evmtypes.NewExecErrorWithReason(revertData).ErrorCode(),
+		},
+		{
+			"failed call",
+			&evmtypes.MsgEthereumTxResponse{VmError: vm.ErrOutOfGas.Error()},
+			ethtypes.ReceiptStatusFailed,
+			0,
+			errCodeVMError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			call := newSimCallResult(tc.res)
+			suite.Require().Equal(hexutil.Uint64(tc.expStatus), call.Status)
+			suite.Require().Equal(hexutil.Uint64(tc.res.GasUsed), call.GasUsed)
+			suite.Require().Len(call.Logs, tc.expLogs)
+			suite.Require().NotNil(call.ReturnData)
+
+			if tc.expCode == 0 {
+				suite.Require().Nil(call.Error)
+				return
+			}
+			suite.Require().NotNil(call.Error)
+			suite.Require().Equal(tc.expCode, call.Error.Code)
+			if tc.res.VmError == vm.ErrExecutionReverted.Error() {
+				suite.Require().Equal(hexutil.Encode(revertData), call.Error.Data)
+			}
+		})
+	}
+}
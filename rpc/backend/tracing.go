@@ -11,6 +11,8 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/evmos/os/rpc/types"
 	evmtypes "github.com/evmos/os/x/evm/types"
 	"github.com/pkg/errors"
@@ -209,3 +211,281 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall executes the given call on top of the state of the requested block
+// and returns the structured logs created during its execution, dependent on
+// the requested tracer. The state and block overrides of the config are applied
+// before tracing the call.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		b.logger.Debug("block not found", "number", blockNr)
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+
+		if config.StateOverrides != nil {
+			if traceCallRequest.Overrides, err = json.Marshal(config.StateOverrides); err != nil {
+				return nil, err
+			}
+		}
+		if config.BlockOverrides != nil {
+			if traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the call is traced on top of the state at the end of the requested block
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// IntermediateRoots executes the Ethereum transactions of the given block and
+// returns a state commitment after the execution of each of them.
+func (b *Backend) IntermediateRoots(block *tmrpctypes.ResultBlock) ([]common.Hash, error) {
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(block, blockRes)
+	if len(msgs) == 0 {
+		return []common.Hash{}, nil
+	}
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.ctx, &block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryIntermediateRootsRequest{
+		Txs:             msgs,
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	// minus one to get the context at the beginning of the block
+	res, err := b.queryClient.IntermediateRoots(rpctypes.ContextWithHeight(contextHeightAtBlockStart(block.Block.Height)), req)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Hash, 0, len(res.Roots))
+	for _, root := range res.Roots {
+		roots = append(roots, common.BytesToHash(root))
+	}
+	return roots, nil
+}
+
+// AccountRange returns the state of a range of accounts at the given block,
+// starting from the given address. The accounts are sorted by address.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage bool,
+) (*rpctypes.AccountRangeResult, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+
+	if maxResults < 0 {
+		maxResults = 0
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		Start:      start,
+		MaxResults: uint64(maxResults), //nolint:gosec // G115 // checked for negative values already
+		NoCode:     nocode,
+		NoStorage:  nostorage,
+	}
+
+	res, err := b.queryClient.AccountRange(rpctypes.ContextWithHeight(block.Block.Height), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.AccountRangeResult{
+		Root:     common.BytesToHash(block.Block.AppHash).Hex(),
+		Accounts: make(map[common.Address]rpctypes.DumpAccount, len(res.Accounts)),
+		Next:     res.Next,
+	}
+
+	for _, acc := range res.Accounts {
+		address := common.HexToAddress(acc.Address)
+		dumpAccount := rpctypes.DumpAccount{
+			Balance:  acc.Balance,
+			Nonce:    acc.Nonce,
+			CodeHash: common.HexToHash(acc.CodeHash).Bytes(),
+			Code:     acc.Code,
+			Address:  &address,
+		}
+		if len(acc.Storage) > 0 {
+			dumpAccount.Storage = make(map[common.Hash]string, len(acc.Storage))
+			for _, state := range acc.Storage {
+				dumpAccount.Storage[common.HexToHash(state.Key)] = state.Value
+			}
+		}
+		result.Accounts[address] = dumpAccount
+	}
+
+	return result, nil
+}
+
+// StorageRangeAt returns the storage of the given contract at the given
+// transaction index of a block, starting from the given storage key.
+func (b *Backend) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (*rpctypes.StorageRangeResult, error) {
+	block, err := b.TendermintBlockByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %s not found", blockHash.Hex())
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(block, blockRes)
+	if txIndex < 0 || txIndex > len(msgs) {
+		return nil, fmt.Errorf("transaction index %d out of range for block %s", txIndex, blockHash.Hex())
+	}
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.ctx, &block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxResult < 0 {
+		maxResult = 0
+	}
+
+	req := &evmtypes.QueryStorageRangeAtRequest{
+		Predecessors:    msgs[:txIndex],
+		Address:         address.Hex(),
+		KeyStart:        keyStart,
+		MaxResults:      uint64(maxResult), //nolint:gosec // G115 // checked for negative values already
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	// minus one to get the context at the beginning of the block
+	res, err := b.queryClient.StorageRangeAt(rpctypes.ContextWithHeight(contextHeightAtBlockStart(block.Block.Height)), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.StorageRangeResult{
+		Storage: make(rpctypes.StorageMap, len(res.Storage)),
+	}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if res.NextKey != "" {
+		nextKey := common.HexToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// contextHeightAtBlockStart returns the height of the context in which the
+// transactions of the block at the given height are executed.
+func contextHeightAtBlockStart(height int64) int64 {
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+	return contextHeight
+}
@@ -11,9 +11,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/os/crypto/ethsecp256k1"
 	"github.com/evmos/os/indexer"
 	"github.com/evmos/os/rpc/backend/mocks"
+	rpctypes "github.com/evmos/os/rpc/types"
 	evmtypes "github.com/evmos/os/x/evm/types"
 )
 
@@ -260,3 +262,297 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	blockNr := rpctypes.BlockNumber(1)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	args := evmtypes.TransactionArgs{From: &suite.from, To: &to}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - trace call query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCallError(queryClient, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, 1)
+			},
+			&rpctypes.TraceCallConfig{
+				TraceConfig:    evmtypes.TraceConfig{Tracer: "callTracer"},
+				StateOverrides: &rpctypes.StateOverride{to: rpctypes.OverrideAccount{}},
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(args, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, tc.config)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestIntermediateRoots() {
+	_, bz := suite.buildEthereumTx()
+	root := common.HexToHash("0x01")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		tx           []byte
+		expRoots     []common.Hash
+		expPass      bool
+	}{
+		{
+			"fail - block results error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockResultsError(client, 1)
+			},
+			bz,
+			nil,
+			false,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			nil,
+			[]common.Hash{},
+			true,
+		},
+		{
+			"pass - block with one transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterIntermediateRoots(queryClient, 1, [][]byte{root.Bytes()})
+			},
+			bz,
+			[]common.Hash{root},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			txs := []types.Tx{}
+			if tc.tx != nil {
+				txs = append(txs, tc.tx)
+			}
+			block := types.MakeBlock(1, txs, nil, nil)
+			roots, err := suite.backend.IntermediateRoots(&tmrpctypes.ResultBlock{Block: block})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRoots, roots)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestAccountRange() {
+	blockNr := rpctypes.BlockNumber(1)
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	next := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	codeHash := common.HexToHash("0x02")
+	key := common.HexToHash("0x03")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccountRangeResult
+		expPass      bool
+	}{
+		{
+			"fail - block error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - one account",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterAccountRange(queryClient, 1, &evmtypes.QueryAccountRangeResponse{
+					Accounts: []evmtypes.DumpAccount{{
+						Address:  address.Hex(),
+						Balance:  "10",
+						Nonce:    1,
+						CodeHash: codeHash.Hex(),
+						Code:     []byte{0x1},
+						Storage:  []evmtypes.State{evmtypes.NewState(key, common.HexToHash("0x04"))},
+					}},
+					Next: next.Bytes(),
+				})
+			},
+			&rpctypes.AccountRangeResult{
+				Accounts: map[common.Address]rpctypes.DumpAccount{
+					address: {
+						Balance:  "10",
+						Nonce:    1,
+						CodeHash: codeHash.Bytes(),
+						Code:     []byte{0x1},
+						Storage:  map[common.Hash]string{key: common.HexToHash("0x04").Hex()},
+						Address:  &address,
+					},
+				},
+				Next: next.Bytes(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.AccountRange(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, nil, 1, false, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult.Accounts, result.Accounts)
+				suite.Require().Equal(tc.expResult.Next, result.Next)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestStorageRangeAt() {
+	_, bz := suite.buildEthereumTx()
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	key := common.HexToHash("0x01")
+	value := common.HexToHash("0x02")
+	nextKey := common.HexToHash("0x03")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		txIndex      int
+		expResult    *rpctypes.StorageRangeResult
+		expPass      bool
+	}{
+		{
+			"fail - block error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHashError(client, common.Hash{}, bz)
+			},
+			0,
+			nil,
+			false,
+		},
+		{
+			"fail - tx index out of range",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			2,
+			nil,
+			false,
+		},
+		{
+			"pass - storage after the first transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterStorageRangeAt(queryClient, 1, &evmtypes.QueryStorageRangeAtResponse{
+					Storage: []evmtypes.State{evmtypes.NewState(key, value)},
+					NextKey: nextKey.Hex(),
+				})
+			},
+			1,
+			&rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{
+					ethcrypto.Keccak256Hash(key.Bytes()): {Key: &key, Value: value},
+				},
+				NextKey: &nextKey,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.StorageRangeAt(common.Hash{}, tc.txIndex, address, nil, 1)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
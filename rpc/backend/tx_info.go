@@ -9,6 +9,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -163,49 +164,22 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
-	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
-		return nil, err
-	}
-
-	cumulativeGasUsed := uint64(0)
 	blockRes, err := b.rpcClient.BlockResults(b.ctx, &res.Height)
 	if err != nil {
 		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
 		return nil, nil
 	}
 
+	cumulativeGasUsed := uint64(0)
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G115 -- checked for int overflow already
 	}
 
-	cumulativeGasUsed += res.CumulativeGasUsed
-
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
-
 	chainID, err := b.ChainID()
 	if err != nil {
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
-	if err != nil {
-		return nil, err
-	}
-
-	// parse tx logs from events
-	msgIndex := int(res.MsgIndex) // #nosec G115 -- checked for int overflow already
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
-	if err != nil {
-		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
-	}
-
 	if res.EthTxIndex == -1 {
 		// Fallback to find tx index by iterating all valid eth transactions
 		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
@@ -221,6 +195,168 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, errors.New("can't find index of ethereum tx")
 	}
 
+	var baseFee *big.Int
+	if ethMsg.AsTransaction().Type() == ethtypes.DynamicFeeTxType {
+		baseFee, err = b.BaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		}
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	return b.formatTxReceipt(ethMsg, res, blockHash, blockRes, cumulativeGasUsed, chainID.ToInt(), baseFee)
+}
+
+// GetBlockReceipts returns the receipts of all the ethereum transactions
+// included in the given block. The block and its results are fetched once and
+// shared by all the receipts.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil {
+		b.logger.Debug("block not found", "number", blockNum)
+		return nil, nil
+	}
+
+	height := resBlock.Block.Height
+	blockRes, err := b.rpcClient.BlockResults(b.ctx, &height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
+		return nil, nil
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", height, "error", err)
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipts := make([]map[string]interface{}, 0, len(resBlock.Block.Txs))
+
+	// ethTxIndex is the index of the valid ethereum txs in the block and
+	// blockGasUsed the gas used by the cosmos txs processed so far. They follow
+	// the same rules as the EVM tx indexer.
+	var (
+		ethTxIndex   int32
+		blockGasUsed uint64
+	)
+	for txIndex, txBz := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[txIndex]
+		cumulativeGasUsed := blockGasUsed
+		blockGasUsed += uint64(txResult.GasUsed) // #nosec G115 -- checked for int overflow already
+
+		if !rpctypes.TxSucessOrExpectedFailure(txResult) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse tx events", "height", height, "index", txIndex, "error", err.Error())
+			continue
+		}
+
+		var msgsGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			res := &types.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
+				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
+				EthTxIndex: ethTxIndex,
+			}
+			if txResult.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				res.GasUsed = ethMsg.GetGas()
+				res.Failed = true
+			} else {
+				parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					b.logger.Debug("msg index not found in events", "height", height, "msgIndex", msgIndex)
+					continue
+				}
+				res.GasUsed = parsedTx.GasUsed
+				res.Failed = parsedTx.Failed
+			}
+
+			msgsGasUsed += res.GasUsed
+			res.CumulativeGasUsed = msgsGasUsed
+			ethTxIndex++
+
+			receipt, err := b.formatTxReceipt(ethMsg, res, blockHash, blockRes, cumulativeGasUsed, chainID.ToInt(), baseFee)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt builds the receipt of an ethereum transaction from its
+// indexed result and the results of the block it was included in.
+// cumulativeGasUsed is the gas used by the cosmos transactions of the block
+// that precede the one including the ethereum transaction. The effective gas
+// price of dynamic fee transactions is only set if baseFee is not nil.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *types.TxResult,
+	blockHash common.Hash,
+	blockRes *tmrpctypes.ResultBlockResults,
+	cumulativeGasUsed uint64,
+	chainID *big.Int,
+	baseFee *big.Int,
+) (map[string]interface{}, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
+	}
+
+	cumulativeGasUsed += res.CumulativeGasUsed
+
+	var status hexutil.Uint
+	if res.Failed {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	}
+
+	from, err := ethMsg.GetSender(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := ethMsg.AsTransaction().Hash()
+
+	// parse tx logs from events
+	msgIndex := int(res.MsgIndex) // #nosec G115 -- checked for int overflow already
+	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+	if err != nil {
+		b.logger.Debug("failed to parse logs", "hash", hash.Hex(), "error", err.Error())
+	}
+
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
@@ -236,7 +372,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),     //nolint:gosec // G115 // won't exceed uint64
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex), //nolint:gosec // G115 // no int overflow expected here
 
@@ -255,14 +391,8 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 	}
 
 	return receipt, nil
@@ -452,3 +582,50 @@ func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, i
 		b.chainID,
 	)
 }
+
+// GetTransactionsByAddress returns the Ethereum format transactions sent from,
+// sent to or creating the given address within the block range, using the
+// address index of the EVM tx indexer.
+func (b *Backend) GetTransactionsByAddress(
+	address common.Address,
+	fromBlock, toBlock rpctypes.BlockNumber,
+) ([]*rpctypes.RPCTransaction, error) {
+	addrIdxr, ok := b.indexer.(types.AddressIndexer)
+	if !ok {
+		return nil, errors.New("address index is not enabled")
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := fromBlock.Int64(), toBlock.Int64()
+	if fromBlock < rpctypes.EthEarliestBlockNumber {
+		from = int64(latest) //#nosec G115 -- int overflow is not a concern here
+	}
+	if toBlock < rpctypes.EthEarliestBlockNumber {
+		to = int64(latest) //#nosec G115 -- int overflow is not a concern here
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if to-from > int64(b.RPCBlockRangeCap()) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", b.RPCBlockRangeCap())
+	}
+
+	hashes, err := addrIdxr.GetByAddress(address, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*rpctypes.RPCTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get transaction %s", hash.Hex())
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
@@ -17,6 +17,7 @@ import (
 	rpctypes "github.com/evmos/os/rpc/types"
 	evmostypes "github.com/evmos/os/types"
 	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -612,6 +613,103 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	height := int64(1)
+	blockNum := rpctypes.BlockNumber(height)
+
+	// two ethereum txs in separate cosmos txs
+	var (
+		txsBz    []types.Tx
+		txHashes []common.Hash
+	)
+	for i := 0; i < 2; i++ {
+		msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.backend.chainID,
+			Nonce:    uint64(i), //#nosec G115 -- int overflow is not a concern here
+			To:       &common.Address{},
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+		})
+		txsBz = append(txsBz, suite.signAndEncodeEthTx(msgEthereumTx))
+		txHashes = append(txHashes, msgEthereumTx.AsTransaction().Hash())
+	}
+
+	block := types.MakeBlock(height, txsBz, nil, nil)
+	block.ChainID = ChainID
+	blockRes := &tmrpctypes.ResultBlockResults{Height: height}
+	for i, txHash := range txHashes {
+		blockRes.TxsResults = append(blockRes.TxsResults, &abci.ExecTxResult{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: fmt.Sprintf("%d", i)},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		})
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  int
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, height)
+			},
+			0,
+		},
+		{
+			"pass - receipts of all the txs in the block",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, height)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+				client.On("Block", rpctypes.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlock{Block: block}, nil)
+				client.On("BlockResults", rpctypes.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+					Return(blockRes, nil)
+			},
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, blockRes.TxsResults)
+			suite.Require().NoError(err)
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			suite.Require().NoError(err)
+			suite.Require().Len(receipts, tc.expReceipts)
+
+			// the receipts must match the ones returned for each tx
+			for i, receipt := range receipts {
+				expReceipt, err := suite.backend.GetTransactionReceipt(txHashes[i])
+				suite.Require().NoError(err)
+				suite.Require().Equal(expReceipt, receipt)
+				suite.Require().Equal(hexutil.Uint64(21000*(i+1)), receipt["cumulativeGasUsed"]) //#nosec G115 -- int overflow is not a concern here
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
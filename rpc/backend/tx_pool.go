@@ -0,0 +1,121 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+// TxPoolContent returns the Ethereum transactions contained in the mempool,
+// grouped by sender. The transactions of each sender are split into pending
+// ones, that are executable given the on-chain account nonce, and queued ones,
+// that are waiting for a nonce gap to be filled.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address][]*evmtypes.MsgEthereumTx, err error,
+) {
+	txsBySender, err := b.pendingEthMsgsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	queued = make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for sender, msgs := range txsBySender {
+		nonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		senderPending, senderQueued := splitTxsByNonce(msgs, nonce)
+		if len(senderPending) > 0 {
+			pending[sender] = senderPending
+		}
+		if len(senderQueued) > 0 {
+			queued[sender] = senderQueued
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// TxPoolContentFrom returns the pending and queued Ethereum transactions
+// contained in the mempool for the given sender address.
+func (b *Backend) TxPoolContentFrom(address common.Address) (
+	pending, queued []*evmtypes.MsgEthereumTx, err error,
+) {
+	txsBySender, err := b.pendingEthMsgsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgs, ok := txsBySender[address]
+	if !ok {
+		return nil, nil, nil
+	}
+
+	nonce, err := b.getAccountNonce(address, false, 0, b.logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending, queued = splitTxsByNonce(msgs, nonce)
+	return pending, queued, nil
+}
+
+// pendingEthMsgsBySender returns the Ethereum transactions contained in the
+// mempool, grouped by sender address.
+func (b *Backend) pendingEthMsgsBySender() (map[common.Address][]*evmtypes.MsgEthereumTx, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			result[sender] = append(result[sender], ethMsg)
+		}
+	}
+
+	return result, nil
+}
+
+// splitTxsByNonce sorts the transactions of a single sender by nonce and
+// splits them into pending and queued transactions, starting from the given
+// account nonce. Transactions with a nonce lower than the account nonce can't
+// be executed anymore and are discarded.
+func splitTxsByNonce(msgs []*evmtypes.MsgEthereumTx, nonce uint64) (pending, queued []*evmtypes.MsgEthereumTx) {
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce()
+	})
+
+	for _, msg := range msgs {
+		txNonce := msg.AsTransaction().Nonce()
+		switch {
+		case txNonce < nonce:
+			continue
+		case txNonce == nonce:
+			pending = append(pending, msg)
+			nonce++
+		default:
+			queued = append(queued, msg)
+		}
+	}
+
+	return pending, queued
+}
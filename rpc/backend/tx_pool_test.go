@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/rpc/backend/mocks"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - unconfirmed txs error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsEmpty(client, nil)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(pending)
+				suite.Require().Empty(queued)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSplitTxsByNonce() {
+	newTx := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		return evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.backend.chainID,
+			Nonce:    nonce,
+			To:       &common.Address{},
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+		})
+	}
+
+	testCases := []struct {
+		name       string
+		txNonces   []uint64
+		nonce      uint64
+		expPending []uint64
+		expQueued  []uint64
+	}{
+		{
+			"no transactions",
+			nil,
+			0,
+			nil,
+			nil,
+		},
+		{
+			"all pending, unordered",
+			[]uint64{2, 0, 1},
+			0,
+			[]uint64{0, 1, 2},
+			nil,
+		},
+		{
+			"nonce gap - queued after gap",
+			[]uint64{1, 2, 4, 5},
+			1,
+			[]uint64{1, 2},
+			[]uint64{4, 5},
+		},
+		{
+			"all queued - first nonce missing",
+			[]uint64{3, 4},
+			2,
+			nil,
+			[]uint64{3, 4},
+		},
+		{
+			"stale nonces are discarded",
+			[]uint64{0, 1, 2},
+			2,
+			[]uint64{2},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			msgs := make([]*evmtypes.MsgEthereumTx, 0, len(tc.txNonces))
+			for _, n := range tc.txNonces {
+				msgs = append(msgs, newTx(n))
+			}
+
+			pending, queued := splitTxsByNonce(msgs, tc.nonce)
+
+			nonces := func(msgs []*evmtypes.MsgEthereumTx) []uint64 {
+				var res []uint64
+				for _, msg := range msgs {
+					res = append(res, msg.AsTransaction().Nonce())
+				}
+				return res
+			}
+			suite.Require().Equal(tc.expPending, nonces(pending))
+			suite.Require().Equal(tc.expQueued, nonces(queued))
+		})
+	}
+}
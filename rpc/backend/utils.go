@@ -4,10 +4,7 @@
 package backend
 
 import (
-	"encoding/json"
-	"fmt"
 	"math/big"
-	"sort"
 	"strings"
 
 	"cosmossdk.io/log"
@@ -18,10 +15,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/consensus/misc"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/os/rpc/types"
 	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -101,113 +98,6 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
-// output: targetOneFeeHistory
-func (b *Backend) processBlock(
-	tendermintBlock *cmtrpctypes.ResultBlock,
-	ethBlock *map[string]interface{},
-	rewardPercentiles []float64,
-	tendermintBlockResult *cmtrpctypes.ResultBlockResults,
-	targetOneFeeHistory *types.OneFeeHistory,
-) error {
-	blockHeight := tendermintBlock.Block.Height
-	blockBaseFee, err := b.BaseFee(tendermintBlockResult)
-	if err != nil {
-		return err
-	}
-
-	// set basefee
-	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		header, err := b.CurrentHeader()
-		if err != nil {
-			return err
-		}
-		targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
-	} else {
-		targetOneFeeHistory.NextBaseFee = new(big.Int)
-	}
-	// set gas used ratio
-	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
-	if !ok {
-		return fmt.Errorf("invalid gas limit type: %T", (*ethBlock)["gasLimit"])
-	}
-
-	gasUsedBig, ok := (*ethBlock)["gasUsed"].(*hexutil.Big)
-	if !ok {
-		return fmt.Errorf("invalid gas used type: %T", (*ethBlock)["gasUsed"])
-	}
-
-	gasusedfloat, _ := new(big.Float).SetInt(gasUsedBig.ToInt()).Float64()
-
-	if gasLimitUint64 <= 0 {
-		return fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
-	}
-
-	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
-	blockGasUsed := gasusedfloat
-	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
-
-	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
-	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
-	}
-
-	// check tendermintTxs
-	tendermintTxs := tendermintBlock.Block.Txs
-	tendermintTxResults := tendermintBlockResult.TxsResults
-	tendermintTxCount := len(tendermintTxs)
-
-	var sorter sortGasAndReward
-
-	for i := 0; i < tendermintTxCount; i++ {
-		eachTendermintTx := tendermintTxs[i]
-		eachTendermintTxResult := tendermintTxResults[i]
-
-		tx, err := b.clientCtx.TxConfig.TxDecoder()(eachTendermintTx)
-		if err != nil {
-			b.logger.Debug("failed to decode transaction in block", "height", blockHeight, "error", err.Error())
-			continue
-		}
-		txGasUsed := uint64(eachTendermintTxResult.GasUsed) // #nosec G115
-		for _, msg := range tx.GetMsgs() {
-			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				continue
-			}
-			tx := ethMsg.AsTransaction()
-			reward := tx.EffectiveGasTipValue(blockBaseFee)
-			if reward == nil {
-				reward = big.NewInt(0)
-			}
-			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
-		}
-	}
-
-	// return an all zero row if there are no transactions to gather data from
-	ethTxCount := len(sorter)
-	if ethTxCount == 0 {
-		return nil
-	}
-
-	sort.Sort(sorter)
-
-	var txIndex int
-	sumGasUsed := sorter[0].gasUsed
-
-	for i, p := range rewardPercentiles {
-		thresholdGasUsed := uint64(blockGasUsed * p / 100) // #nosec G115
-		for sumGasUsed < thresholdGasUsed && txIndex < ethTxCount-1 {
-			txIndex++
-			sumGasUsed += sorter[txIndex].gasUsed
-		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
-	}
-
-	return nil
-}
-
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
 func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
 	allLogs := make([][]*ethtypes.Log, 0, 4)
@@ -228,38 +118,12 @@ func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
 
 // TxLogsFromEvents parses ethereum logs from cosmos events for specific msg index
 func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error) {
-	for _, event := range events {
-		if event.Type != evmtypes.EventTypeTxLog {
-			continue
-		}
-
-		if msgIndex > 0 {
-			// not the eth tx we want
-			msgIndex--
-			continue
-		}
-
-		return ParseTxLogsFromEvent(event)
-	}
-	return nil, fmt.Errorf("eth tx logs not found for message index %d", msgIndex)
+	return types.TxLogsFromEvents(events, msgIndex)
 }
 
 // ParseTxLogsFromEvent parse tx logs from one event
 func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
-	logs := make([]*evmtypes.Log, 0, len(event.Attributes))
-	for _, attr := range event.Attributes {
-		if attr.Key != evmtypes.AttributeKeyTxLog {
-			continue
-		}
-
-		var txLog evmtypes.Log
-		if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
-			return nil, err
-		}
-
-		logs = append(logs, &txLog)
-	}
-	return evmtypes.LogsToEthereum(logs), nil
+	return types.ParseTxLogsFromEvent(event)
 }
 
 // ShouldIgnoreGasUsed returns true if the gasUsed in result should be ignored
@@ -282,6 +146,23 @@ func GetLogsFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) ([][]*eth
 	return blockLogs, nil
 }
 
+// BlockBloomFromBlockResults returns the block bloom from the block bloom event
+// emitted at the end of the block
+func BlockBloomFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	for _, event := range blockRes.FinalizeBlockEvents {
+		if event.Type != evmtypes.EventTypeBlockBloom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
+				return ethtypes.BytesToBloom([]byte(attr.Value)), nil
+			}
+		}
+	}
+	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
+}
+
 // GetHexProofs returns list of hex data of proof op
 func GetHexProofs(proof *crypto.ProofOps) []string {
 	if proof == nil {
@@ -0,0 +1,400 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/evmos/os/server/config"
+)
+
+const (
+	// APIKeyHeader is the HTTP header carrying the API key of a request.
+	APIKeyHeader = "X-API-Key"
+	// APIKeyQueryParam is the URL query parameter carrying the API key of a
+	// request, for the clients that cannot set headers such as browsers
+	// opening a websocket.
+	APIKeyQueryParam = "apikey"
+
+	// internalRequestHeader marks the requests forwarded by the websocket
+	// server to the HTTP server, which have already been checked.
+	internalRequestHeader = "X-Evmos-Internal-Request"
+
+	// limiterIdleTimeout is the time after which the rate limiter of an idle
+	// client is dropped.
+	limiterIdleTimeout = 10 * time.Minute
+
+	// JSON-RPC error codes of the rejected requests
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeUnauthorized   = -32001
+	errCodeLimitExceeded  = -32005
+)
+
+// GuardError is the error of a request rejected by the RequestGuard.
+type GuardError struct {
+	Code       int
+	Message    string
+	HTTPStatus int
+}
+
+// Error implements the error interface.
+func (e *GuardError) Error() string { return e.Message }
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *GuardError) ErrorCode() int { return e.Code }
+
+// jsonrpcCall is the ID, method and parameters of a JSON-RPC request.
+type jsonrpcCall struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// parseCalls parses a JSON-RPC request or batch of requests.
+func parseCalls(body []byte) (calls []jsonrpcCall, batch bool, err error) {
+	if isBatch(body) {
+		err = json.Unmarshal(body, &calls)
+		return calls, true, err
+	}
+
+	var call jsonrpcCall
+	if err := json.Unmarshal(body, &call); err != nil {
+		return nil, false, err
+	}
+	return []jsonrpcCall{call}, false, nil
+}
+
+// guardClient identifies the client sending a request.
+type guardClient struct {
+	ip     string
+	apiKey string
+}
+
+// clientFromRequest returns the IP address and the API key of the client
+// sending the given HTTP request.
+func clientFromRequest(r *http.Request) guardClient {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	apiKey := r.Header.Get(APIKeyHeader)
+	if apiKey == "" {
+		apiKey = r.URL.Query().Get(APIKeyQueryParam)
+	}
+	return guardClient{ip: ip, apiKey: apiKey}
+}
+
+// RequestGuard enforces the access controls of the JSON-RPC config on the
+// HTTP and websocket requests: API keys, per-IP and per-API-key rate limits,
+// allowed and denied methods, allowed CORS origins, and request body and batch
+// size limits.
+type RequestGuard struct {
+	apiKeys        map[string]struct{}
+	requireAPIKey  bool
+	ipLimiters     *rateLimiters
+	apiKeyLimiters *rateLimiters
+	allowedMethods []string
+	deniedMethods  []string
+	corsOrigins    []string
+	maxBodySize    int64
+	batchLimit     int
+	internalToken  string
+}
+
+// NewRequestGuard returns a RequestGuard enforcing the given config.
+func NewRequestGuard(cfg config.JSONRPCConfig) *RequestGuard {
+	apiKeys := make(map[string]struct{}, len(cfg.APIKeys))
+	for _, key := range cfg.APIKeys {
+		apiKeys[key] = struct{}{}
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+
+	return &RequestGuard{
+		apiKeys:        apiKeys,
+		requireAPIKey:  cfg.RequireAPIKey,
+		ipLimiters:     newRateLimiters(cfg.RateLimit, cfg.RateLimitBurst),
+		apiKeyLimiters: newRateLimiters(cfg.APIKeyRateLimit, cfg.APIKeyRateLimitBurst),
+		allowedMethods: cfg.AllowedMethods,
+		deniedMethods:  cfg.DeniedMethods,
+		corsOrigins:    cfg.CORSAllowedOrigins,
+		maxBodySize:    cfg.MaxRequestBodySize,
+		batchLimit:     cfg.BatchRequestLimit,
+		internalToken:  hex.EncodeToString(token),
+	}
+}
+
+// MaxBodySize returns the maximum size in bytes of a request body, or 0 if
+// there is no limit.
+func (g *RequestGuard) MaxBodySize() int64 {
+	return g.maxBodySize
+}
+
+// CORSOrigins returns the origins allowed to send cross-origin requests.
+func (g *RequestGuard) CORSOrigins() []string {
+	return g.corsOrigins
+}
+
+// AllowOrigin returns true if the given origin is allowed to send
+// cross-origin requests. Requests without origin, which are not sent by
+// browsers, are always allowed. An empty list allows all the origins, as it
+// does for the CORS handler of the HTTP server.
+func (g *RequestGuard) AllowOrigin(origin string) bool {
+	if origin == "" || len(g.corsOrigins) == 0 {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	for _, allowed := range g.corsOrigins {
+		allowed = strings.ToLower(allowed)
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+		// a single wildcard matches any part of the origin, e.g. https://*.example.com
+		if prefix, suffix, ok := strings.Cut(allowed, "*"); ok &&
+			len(origin) >= len(prefix)+len(suffix) &&
+			strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowMethod returns true if the given method can be called. A method can be
+// called if it matches the allowed methods or doesn't match the denied ones,
+// so that a namespace can be denied while keeping some of its methods. The
+// patterns are either method names or namespace wildcards such as "debug_*".
+func (g *RequestGuard) AllowMethod(method string) bool {
+	return matchMethod(g.allowedMethods, method) || !matchMethod(g.deniedMethods, method)
+}
+
+// matchMethod returns true if the method matches any of the given patterns.
+func matchMethod(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" || pattern == method {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handler returns an HTTP handler checking the requests before passing them
+// to the given handler.
+func (g *RequestGuard) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.isInternal(r) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), forwardedRequestKey{}, true)))
+			return
+		}
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if g.maxBodySize > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, g.maxBodySize)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeGuardError(w, &GuardError{
+				Code:       errCodeInvalidRequest,
+				Message:    fmt.Sprintf("request body too large, limit is %d bytes", g.maxBodySize),
+				HTTPStatus: http.StatusRequestEntityTooLarge,
+			})
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := g.check(clientFromRequest(r), body); err != nil {
+			writeGuardError(w, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeGuardError writes the JSON-RPC error response of a rejected request.
+func writeGuardError(w http.ResponseWriter, err *GuardError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.HTTPStatus)
+	_ = json.NewEncoder(w).Encode(newGuardErrorResponse(err)) // #nosec G703
+}
+
+// newGuardErrorResponse returns the JSON-RPC error response of a rejected
+// request.
+func newGuardErrorResponse(err *GuardError) map[string]interface{} {
+	return map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error": map[string]interface{}{
+			"code":    err.Code,
+			"message": err.Message,
+		},
+	}
+}
+
+// markInternal marks a request forwarded by the websocket server to the HTTP
+// server, so that it is not checked twice.
+func (g *RequestGuard) markInternal(r *http.Request) {
+	r.Header.Set(internalRequestHeader, g.internalToken)
+}
+
+// isInternal returns true if the request was forwarded by the websocket
+// server.
+func (g *RequestGuard) isInternal(r *http.Request) bool {
+	return r.Header.Get(internalRequestHeader) == g.internalToken
+}
+
+// forwardedRequestKey is the context key flagging the requests forwarded by
+// the websocket server, once checked by the RequestGuard handler.
+type forwardedRequestKey struct{}
+
+// isForwarded returns true if the context is the one of a request forwarded
+// by the websocket server.
+func isForwarded(ctx context.Context) bool {
+	forwarded, _ := ctx.Value(forwardedRequestKey{}).(bool)
+	return forwarded
+}
+
+// authenticate checks the API key of the client, if any.
+func (g *RequestGuard) authenticate(client guardClient) *GuardError {
+	if client.apiKey == "" {
+		if g.requireAPIKey {
+			return &GuardError{Code: errCodeUnauthorized, Message: "missing API key", HTTPStatus: http.StatusUnauthorized}
+		}
+		return nil
+	}
+	if _, ok := g.apiKeys[client.apiKey]; !ok {
+		return &GuardError{Code: errCodeUnauthorized, Message: "invalid API key", HTTPStatus: http.StatusUnauthorized}
+	}
+	return nil
+}
+
+// check authenticates the client and checks the methods, batch size and rate
+// limit of a JSON-RPC request or batch of requests. Bodies that are not valid
+// JSON-RPC messages are let through, the server replies with a parse error
+// without calling any method.
+func (g *RequestGuard) check(client guardClient, body []byte) *GuardError {
+	if err := g.authenticate(client); err != nil {
+		return err
+	}
+
+	msgs, batch, err := parseCalls(body)
+	if err != nil {
+		return nil
+	}
+	if batch && g.batchLimit > 0 && len(msgs) > g.batchLimit {
+		return &GuardError{
+			Code:       errCodeInvalidRequest,
+			Message:    fmt.Sprintf("batch too large, limit is %d requests", g.batchLimit),
+			HTTPStatus: http.StatusOK,
+		}
+	}
+
+	for _, msg := range msgs {
+		if !g.AllowMethod(msg.Method) {
+			return &GuardError{
+				Code:       errCodeMethodNotFound,
+				Message:    fmt.Sprintf("the method %s does not exist/is not available", msg.Method),
+				HTTPStatus: http.StatusOK,
+			}
+		}
+	}
+
+	// every request of a batch consumes a token
+	n := len(msgs)
+	if n == 0 {
+		n = 1
+	}
+	limiters, key := g.ipLimiters, client.ip
+	if client.apiKey != "" {
+		limiters, key = g.apiKeyLimiters, client.apiKey
+	}
+	if !limiters.allow(key, n) {
+		return &GuardError{Code: errCodeLimitExceeded, Message: "rate limit exceeded", HTTPStatus: http.StatusTooManyRequests}
+	}
+	return nil
+}
+
+// rateLimiters holds a token bucket per client, identified by its IP address
+// or API key.
+type rateLimiters struct {
+	mtx       sync.Mutex
+	limit     rate.Limit
+	burst     int
+	limiters  map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+// clientLimiter is the token bucket of a client.
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newRateLimiters returns the rate limiters allowing the given number of
+// requests per second to every client. A zero rate disables the limits. The
+// burst defaults to the rate.
+func newRateLimiters(limit float64, burst int) *rateLimiters {
+	if limit > 0 && burst <= 0 {
+		burst = int(limit)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+	return &rateLimiters{
+		limit:    rate.Limit(limit),
+		burst:    burst,
+		limiters: make(map[string]*clientLimiter),
+	}
+}
+
+// allow consumes n tokens of the bucket of the given client, and returns false
+// if there are not enough tokens.
+func (l *rateLimiters) allow(key string, n int) bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) > limiterIdleTimeout {
+		for k, cl := range l.limiters {
+			if now.Sub(cl.lastSeen) > limiterIdleTimeout {
+				delete(l.limiters, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	cl, ok := l.limiters[key]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[key] = cl
+	}
+	cl.lastSeen = now
+	return cl.limiter.AllowN(now, n)
+}
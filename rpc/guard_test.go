@@ -0,0 +1,212 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/os/server/config"
+)
+
+func TestAllowMethod(t *testing.T) {
+	guard := NewRequestGuard(config.JSONRPCConfig{
+		AllowedMethods: []string{"debug_traceTransaction"},
+		DeniedMethods:  []string{"debug_*", "admin_peers"},
+	})
+
+	testCases := []struct {
+		method  string
+		expPass bool
+	}{
+		{"eth_call", true},
+		{"debug_traceTransaction", true},
+		{"debug_traceBlockByNumber", false},
+		{"admin_peers", false},
+		{"admin_nodeInfo", true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPass, guard.AllowMethod(tc.method), tc.method)
+	}
+}
+
+func TestAllowOrigin(t *testing.T) {
+	guard := NewRequestGuard(config.JSONRPCConfig{
+		CORSAllowedOrigins: []string{"https://app.example.com", "https://*.evmos.org"},
+	})
+
+	testCases := []struct {
+		origin  string
+		expPass bool
+	}{
+		{"", true},
+		{"https://app.example.com", true},
+		{"HTTPS://APP.EXAMPLE.COM", true},
+		{"https://wallet.evmos.org", true},
+		{"https://evmos.org", false},
+		{"https://example.com", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPass, guard.AllowOrigin(tc.origin), tc.origin)
+	}
+
+	require.True(t, NewRequestGuard(*config.DefaultJSONRPCConfig()).AllowOrigin("https://any.origin"))
+	require.True(t, NewRequestGuard(config.JSONRPCConfig{}).AllowOrigin("https://any.origin"))
+}
+
+func TestGuardCheck(t *testing.T) {
+	cfg := config.JSONRPCConfig{
+		APIKeys:              []string{"key"},
+		RateLimit:            1,
+		RateLimitBurst:       2,
+		APIKeyRateLimit:      10,
+		APIKeyRateLimitBurst: 10,
+		DeniedMethods:        []string{"debug_*"},
+		BatchRequestLimit:    3,
+	}
+	call := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+	batch := func(n int) []byte {
+		calls := make([]string, n)
+		for i := range calls {
+			calls[i] = string(call)
+		}
+		return []byte("[" + strings.Join(calls, ",") + "]")
+	}
+
+	testCases := []struct {
+		name    string
+		client  guardClient
+		bodies  [][]byte
+		expCode int
+	}{
+		{
+			"allowed call",
+			guardClient{ip: "1.1.1.1"},
+			[][]byte{call},
+			0,
+		},
+		{
+			"invalid JSON is let through",
+			guardClient{ip: "1.1.1.1"},
+			[][]byte{[]byte("{")},
+			0,
+		},
+		{
+			"denied method",
+			guardClient{ip: "1.1.1.1"},
+			[][]byte{[]byte(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`)},
+			errCodeMethodNotFound,
+		},
+		{
+			"denied method in batch",
+			guardClient{ip: "1.1.1.1"},
+			[][]byte{[]byte(`[` + string(call) + `,{"jsonrpc":"2.0","id":2,"method":"debug_getRawBlock"}]`)},
+			errCodeMethodNotFound,
+		},
+		{
+			"batch too large",
+			guardClient{ip: "1.1.1.1"},
+			[][]byte{batch(4)},
+			errCodeInvalidRequest,
+		},
+		{
+			"IP rate limit exceeded",
+			guardClient{ip: "1.1.1.1"},
+			[][]byte{call, call, call},
+			errCodeLimitExceeded,
+		},
+		{
+			"batch exceeding the IP rate limit",
+			guardClient{ip: "1.1.1.1"},
+			[][]byte{batch(3)},
+			errCodeLimitExceeded,
+		},
+		{
+			"API key rate limit",
+			guardClient{ip: "1.1.1.1", apiKey: "key"},
+			[][]byte{call, call, call, batch(3)},
+			0,
+		},
+		{
+			"invalid API key",
+			guardClient{ip: "1.1.1.1", apiKey: "invalid"},
+			[][]byte{call},
+			errCodeUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			guard := NewRequestGuard(cfg)
+
+			var err *GuardError
+			for _, body := range tc.bodies {
+				if err = guard.check(tc.client, body); err != nil {
+					break
+				}
+			}
+			if tc.expCode == 0 {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			require.Equal(t, tc.expCode, err.ErrorCode())
+		})
+	}
+}
+
+func TestRequireAPIKey(t *testing.T) {
+	guard := NewRequestGuard(config.JSONRPCConfig{APIKeys: []string{"key"}, RequireAPIKey: true})
+	call := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+
+	err := guard.check(guardClient{ip: "1.1.1.1"}, call)
+	require.NotNil(t, err)
+	require.Equal(t, errCodeUnauthorized, err.ErrorCode())
+	require.Nil(t, guard.check(guardClient{ip: "1.1.1.1", apiKey: "key"}, call))
+}
+
+func TestGuardHandler(t *testing.T) {
+	guard := NewRequestGuard(config.JSONRPCConfig{
+		APIKeys:            []string{"key"},
+		MaxRequestBodySize: 64,
+		DeniedMethods:      []string{"debug_*"},
+	})
+	handler := guard.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(body string, setup func(r *http.Request)) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if setup != nil {
+			setup(req)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Body.String())
+
+	rec = serve(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "does not exist/is not available")
+
+	rec = serve(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":["`+strings.Repeat("0", 64)+`"]}`, nil)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = serve(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, func(r *http.Request) {
+		r.Header.Set(APIKeyHeader, "invalid")
+	})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// the requests forwarded by the websocket server are not checked again
+	rec = serve(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`, guard.markInternal)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Body.String())
+}
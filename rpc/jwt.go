@@ -0,0 +1,108 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package rpc
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// jwtSecretLength is the length in bytes of the JWT secret.
+	jwtSecretLength = 32
+
+	// jwtIssuedAtDrift is the maximum difference between the issue time of a
+	// token and the time of the node, as allowed by the Engine API spec.
+	jwtIssuedAtDrift = 60 * time.Second
+)
+
+// ObtainJWTSecret loads the hex encoded JWT secret of the given file. A new
+// secret is generated and written to the file if the file doesn't exist.
+func ObtainJWTSecret(path string) (secret []byte, generated bool, err error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err == nil {
+		secret, err = hexutil.Decode(ensureHexPrefix(string(data)))
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid JWT secret in %s: %w", path, err)
+		}
+		if len(secret) != jwtSecretLength {
+			return nil, false, fmt.Errorf("invalid JWT secret length in %s, expected %d bytes, got %d", path, jwtSecretLength, len(secret))
+		}
+		return secret, false, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, false, err
+	}
+
+	secret = make([]byte, jwtSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, false, err
+	}
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, false, err
+	}
+	return secret, true, nil
+}
+
+// ensureHexPrefix adds the 0x prefix to the secret if missing, both forms are
+// accepted.
+func ensureHexPrefix(s string) string {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return s
+	}
+	return "0x" + s
+}
+
+// NewJWTHandler returns an HTTP handler passing the requests to the given
+// handler only if they are authenticated by a HS256 token signed with the
+// given secret, in the Authorization header. As in the Engine API, the token
+// must be issued at most 60 seconds before or after the time of the node.
+func NewJWTHandler(secret []byte, next http.Handler) http.Handler {
+	keyFunc := func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			http.Error(w, "missing token", http.StatusUnauthorized)
+			return
+		}
+
+		// the issue time is checked below with the allowed drift
+		var claims jwt.RegisteredClaims
+		parsed, err := jwt.ParseWithClaims(token, &claims, keyFunc,
+			jwt.WithValidMethods([]string{"HS256"}),
+			jwt.WithoutClaimsValidation(),
+		)
+		switch {
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case !parsed.Valid:
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+		case !claims.VerifyExpiresAt(time.Now(), false):
+			http.Error(w, "token is expired", http.StatusUnauthorized)
+		case claims.IssuedAt == nil:
+			http.Error(w, "missing issued-at", http.StatusUnauthorized)
+		case time.Since(claims.IssuedAt.Time) > jwtIssuedAtDrift:
+			http.Error(w, "stale token", http.StatusUnauthorized)
+		case time.Until(claims.IssuedAt.Time) > jwtIssuedAtDrift:
+			http.Error(w, "future token", http.StatusUnauthorized)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObtainJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "jwtsecret.hex")
+
+	secret, generated, err := ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.True(t, generated)
+	require.Len(t, secret, jwtSecretLength)
+
+	loaded, generated, err := ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.False(t, generated)
+	require.Equal(t, secret, loaded)
+
+	// the secret can be written without 0x prefix
+	unprefixed := filepath.Join(t.TempDir(), "jwtsecret.hex")
+	require.NoError(t, os.WriteFile(unprefixed, []byte("  0102030405060708091011121314151617181920212223242526272829303132\n"), 0o600))
+	loaded, _, err = ObtainJWTSecret(unprefixed)
+	require.NoError(t, err)
+	require.Len(t, loaded, jwtSecretLength)
+
+	invalid := filepath.Join(t.TempDir(), "jwtsecret.hex")
+	require.NoError(t, os.WriteFile(invalid, []byte("0x0102"), 0o600))
+	_, _, err = ObtainJWTSecret(invalid)
+	require.Error(t, err)
+}
+
+func TestJWTHandler(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	handler := NewJWTHandler(secret, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	sign := func(key []byte, method jwt.SigningMethod, claims jwt.Claims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+	issuedAt := func(d time.Duration) jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(time.Now().Add(d))}
+	}
+
+	testCases := []struct {
+		name      string
+		token     string
+		expStatus int
+	}{
+		{"valid token", sign(secret, jwt.SigningMethodHS256, issuedAt(0)), http.StatusOK},
+		{"valid token with drift", sign(secret, jwt.SigningMethodHS256, issuedAt(-30*time.Second)), http.StatusOK},
+		{"missing token", "", http.StatusUnauthorized},
+		{"invalid signature", sign([]byte("invalid"), jwt.SigningMethodHS256, issuedAt(0)), http.StatusUnauthorized},
+		{"invalid method", sign(secret, jwt.SigningMethodHS512, issuedAt(0)), http.StatusUnauthorized},
+		{"missing issued-at", sign(secret, jwt.SigningMethodHS256, jwt.RegisteredClaims{}), http.StatusUnauthorized},
+		{"stale token", sign(secret, jwt.SigningMethodHS256, issuedAt(-2*time.Minute)), http.StatusUnauthorized},
+		{"future token", sign(secret, jwt.SigningMethodHS256, issuedAt(2*time.Minute)), http.StatusUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.expStatus, rec.Code)
+		})
+	}
+}
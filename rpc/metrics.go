@@ -0,0 +1,221 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package rpc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// transports of the JSON-RPC requests, as found in the metric names
+	transportHTTP = "http"
+	transportWS   = "ws"
+
+	// unknownMethod is the method name under which the calls to the methods
+	// that don't exist are recorded, so that the number of metrics doesn't
+	// depend on the requests.
+	unknownMethod = "unknown"
+	// batchMethod is the method name under which the durations of the batches
+	// of requests are recorded.
+	batchMethod = "batch"
+)
+
+// RPCMetrics records the number of calls and the duration of every JSON-RPC
+// method, by transport, in the go-ethereum metrics registry, which is served
+// by the metrics server. The outcome of the calls served by the go-ethereum
+// RPC server is recorded by the server itself, in the
+// rpc/duration/<method>/success and rpc/duration/<method>/failure histograms,
+// so the responses are passed through as they are written. It also logs the
+// calls slower than the configured threshold.
+type RPCMetrics struct {
+	// methods are the names of the served methods, the calls to any other
+	// method are recorded as unknown
+	methods map[string]struct{}
+	// slowThreshold is the duration above which the calls are logged, 0
+	// disables the logs
+	slowThreshold time.Duration
+	logger        log.Logger
+}
+
+// NewRPCMetrics returns the RPCMetrics of the given methods, logging the calls
+// slower than the given threshold.
+func NewRPCMetrics(logger log.Logger, slowThreshold time.Duration, methods []string) *RPCMetrics {
+	methodSet := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		methodSet[method] = struct{}{}
+	}
+
+	return &RPCMetrics{
+		methods:       methodSet,
+		slowThreshold: slowThreshold,
+		logger:        logger.With("module", "rpc-metrics"),
+	}
+}
+
+// subscriptionType is the type returned by the subscription methods.
+var subscriptionType = reflect.TypeOf((*rpc.Subscription)(nil))
+
+// APIMethods returns the names of the JSON-RPC methods served by the given
+// APIs, following the naming of the go-ethereum RPC server. The subscription
+// methods are served through the subscribe and unsubscribe methods of their
+// namespace.
+func APIMethods(apis []rpc.API) []string {
+	var methods []string
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		subscriptions := false
+		for i := 0; i < typ.NumMethod(); i++ {
+			method := typ.Method(i)
+			if method.Type.NumOut() > 0 && method.Type.Out(0) == subscriptionType {
+				subscriptions = true
+				continue
+			}
+			name := strings.ToLower(method.Name[:1]) + method.Name[1:]
+			methods = append(methods, api.Namespace+"_"+name)
+		}
+		if subscriptions {
+			methods = append(methods, api.Namespace+"_subscribe", api.Namespace+"_unsubscribe")
+		}
+	}
+	return methods
+}
+
+// methodName returns the name under which the calls to a method are recorded.
+// The calls to the methods that are not served are recorded as unknown, so
+// that the requests can't register an unbounded number of metrics.
+func (m *RPCMetrics) methodName(method string) string {
+	if _, ok := m.methods[method]; !ok {
+		return unknownMethod
+	}
+	return method
+}
+
+// enabled returns true if the calls are either recorded or logged.
+func (m *RPCMetrics) enabled() bool {
+	return metrics.Enabled || m.slowThreshold > 0
+}
+
+// Handler returns an HTTP handler recording the JSON-RPC calls served by the
+// given handler. The requests forwarded by the websocket server are recorded
+// as websocket calls.
+func (m *RPCMetrics) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !m.enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		elapsed := time.Since(start)
+
+		transport := transportHTTP
+		if isForwarded(r.Context()) {
+			transport = transportWS
+		}
+		m.recordRequest(transport, body, elapsed)
+	})
+}
+
+// recordRequest records the calls of a JSON-RPC request or batch of requests.
+func (m *RPCMetrics) recordRequest(transport string, req []byte, elapsed time.Duration) {
+	calls, batch, err := parseCalls(req)
+	if err != nil {
+		return
+	}
+
+	if !batch {
+		m.record(transport, calls[0].Method, calls[0].Params, elapsed)
+		return
+	}
+
+	// the calls of a batch are served together, so only the duration of the
+	// whole batch is known
+	for _, call := range calls {
+		m.count(transport, m.methodName(call.Method))
+	}
+	m.observe(transport, batchMethod, req, elapsed)
+}
+
+// record records a JSON-RPC call along with its duration.
+func (m *RPCMetrics) record(transport, method string, params json.RawMessage, elapsed time.Duration) {
+	method = m.methodName(method)
+	m.count(transport, method)
+	m.observe(transport, method, params, elapsed)
+}
+
+// recordError records the failure of a JSON-RPC call served by the websocket
+// server, the failures of the other calls are recorded by the go-ethereum RPC
+// server.
+func (m *RPCMetrics) recordError(transport, method string) {
+	if !metrics.Enabled {
+		return
+	}
+	name := fmt.Sprintf("rpc/%s/%s/errors", transport, m.methodName(method))
+	metrics.GetOrRegisterCounter(name, nil).Inc(1)
+}
+
+// count increments the call counter of a JSON-RPC method.
+func (m *RPCMetrics) count(transport, method string) {
+	if !metrics.Enabled {
+		return
+	}
+	metrics.GetOrRegisterCounter(fmt.Sprintf("rpc/%s/%s/requests", transport, method), nil).Inc(1)
+}
+
+// observe records the duration of a JSON-RPC call, and logs it if it is
+// slower than the threshold.
+func (m *RPCMetrics) observe(transport, method string, params []byte, elapsed time.Duration) {
+	if m.slowThreshold > 0 && elapsed >= m.slowThreshold {
+		m.logger.Warn(
+			"slow JSON-RPC call",
+			"transport", transport,
+			"method", method,
+			"params_hash", paramsHash(params),
+			"duration", elapsed.String(),
+		)
+	}
+
+	if !metrics.Enabled {
+		return
+	}
+	sampler := func() metrics.Sample {
+		return metrics.ResettingSample(metrics.NewExpDecaySample(1028, 0.015))
+	}
+	histogram := fmt.Sprintf("rpc/%s/%s/duration", transport, method)
+	metrics.GetOrRegisterHistogramLazy(histogram, nil, sampler).Update(elapsed.Microseconds())
+}
+
+// paramsHash returns a short hash of the parameters of a JSON-RPC call, so
+// that slow calls can be matched without logging their parameters.
+func paramsHash(params []byte) string {
+	hash := sha256.Sum256(params)
+	return hex.EncodeToString(hash[:8])
+}
+
+// subscriptionGauge returns the gauge of the active websocket subscriptions of
+// the given type.
+func subscriptionGauge(subscription string) metrics.Gauge {
+	return metrics.GetOrRegisterGauge(fmt.Sprintf("rpc/ws/subscriptions/%s", subscription), nil)
+}
@@ -0,0 +1,174 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCMetricsHandler(t *testing.T) {
+	enabled := metrics.Enabled
+	metrics.Enabled = true
+	t.Cleanup(func() { metrics.Enabled = enabled })
+
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	require.NoError(t, server.RegisterName("metrics", &metricsService{}))
+	methods := APIMethods([]rpc.API{{Namespace: "metrics", Service: &metricsService{}}})
+	handler := NewRPCMetrics(log.NewNopLogger(), time.Nanosecond, methods).Handler(server)
+
+	counter := func(name string) int64 {
+		return metrics.GetOrRegisterCounter(name, nil).Count()
+	}
+	// the failures are recorded by the go-ethereum RPC server
+	failures := func(method string) int64 {
+		histogram, ok := metrics.DefaultRegistry.Get(fmt.Sprintf("rpc/duration/%s/failure", method)).(metrics.Histogram)
+		if !ok {
+			return 0
+		}
+		return histogram.Count()
+	}
+
+	// the method of the notification is not served, and must not register
+	// its own metrics
+	randomBz := make([]byte, 8)
+	_, err := rand.Read(randomBz)
+	require.NoError(t, err)
+	randomMethod := "metrics_" + hex.EncodeToString(randomBz)
+
+	testCases := []struct {
+		name        string
+		request     string
+		forwarded   bool
+		expCounts   map[string]int64
+		expFailures map[string]int64
+	}{
+		{
+			"successful call",
+			`{"jsonrpc":"2.0","id":1,"method":"metrics_success"}`,
+			false,
+			map[string]int64{
+				"rpc/http/metrics_success/requests": 1,
+			},
+			map[string]int64{
+				"metrics_success": 0,
+			},
+		},
+		{
+			"failed call",
+			`{"jsonrpc":"2.0","id":1,"method":"metrics_failure"}`,
+			false,
+			map[string]int64{
+				"rpc/http/metrics_failure/requests": 1,
+			},
+			map[string]int64{
+				"metrics_failure": 1,
+			},
+		},
+		{
+			"unknown method",
+			`{"jsonrpc":"2.0","id":1,"method":"metrics_doesNotExist"}`,
+			false,
+			map[string]int64{
+				"rpc/http/metrics_doesNotExist/requests": 0,
+				"rpc/http/unknown/requests":              1,
+			},
+			nil,
+		},
+		{
+			"notification of a method that is not served",
+			fmt.Sprintf(`{"jsonrpc":"2.0","method":%q}`, randomMethod),
+			false,
+			map[string]int64{
+				"rpc/http/unknown/requests": 2,
+			},
+			nil,
+		},
+		{
+			"batch",
+			`[{"jsonrpc":"2.0","id":1,"method":"metrics_success"},{"jsonrpc":"2.0","id":"2","method":"metrics_failure"}]`,
+			false,
+			map[string]int64{
+				"rpc/http/metrics_success/requests": 2,
+				"rpc/http/metrics_failure/requests": 2,
+			},
+			map[string]int64{
+				"metrics_success": 0,
+				"metrics_failure": 2,
+			},
+		},
+		{
+			"call forwarded by the websocket server",
+			`{"jsonrpc":"2.0","id":1,"method":"metrics_success"}`,
+			true,
+			map[string]int64{
+				"rpc/ws/metrics_success/requests":   1,
+				"rpc/http/metrics_success/requests": 2,
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.request))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.forwarded {
+				req = req.WithContext(context.WithValue(req.Context(), forwardedRequestKey{}, true))
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			for name, count := range tc.expCounts {
+				require.Equal(t, count, counter(name), name)
+			}
+			for method, count := range tc.expFailures {
+				require.Equal(t, count, failures(method), method)
+			}
+		})
+	}
+
+	histogram := metrics.DefaultRegistry.Get("rpc/http/batch/duration")
+	require.NotNil(t, histogram)
+	require.Nil(t, metrics.DefaultRegistry.Get(fmt.Sprintf("rpc/http/%s/requests", randomMethod)))
+	require.Nil(t, metrics.DefaultRegistry.Get(fmt.Sprintf("rpc/http/%s/duration", randomMethod)))
+}
+
+type metricsService struct{}
+
+func (*metricsService) Success() (uint64, error) { return 1, nil }
+
+func (*metricsService) Failure() (uint64, error) { return 0, errors.New("failure") }
+
+func TestAPIMethods(t *testing.T) {
+	methods := APIMethods([]rpc.API{
+		{Namespace: "test", Service: &testService{}},
+	})
+	require.ElementsMatch(t, []string{"test_blockNumber", "test_subscribe", "test_unsubscribe"}, methods)
+}
+
+type testService struct{}
+
+func (*testService) BlockNumber() (uint64, error) { return 1, nil }
+
+func (*testService) NewHeads(context.Context) (*rpc.Subscription, error) { return nil, nil }
+
+func TestParamsHash(t *testing.T) {
+	params := []byte(`["0x1",true]`)
+	require.Len(t, paramsHash(params), 16)
+	require.Equal(t, paramsHash(params), paramsHash([]byte(`["0x1",true]`)))
+	require.NotEqual(t, paramsHash(params), paramsHash([]byte(`["0x2",true]`)))
+}
@@ -0,0 +1,114 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package cosmos
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/log"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/rpc/backend"
+	rpctypes "github.com/evmos/os/rpc/types"
+	"github.com/evmos/os/utils"
+)
+
+// PublicAPI is the cosmos_ prefixed set of APIs. It allows to correlate the
+// Ethereum transactions and accounts with their Cosmos counterparts without a
+// separate connection to the Cosmos REST or gRPC endpoints.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.CosmosBackend
+}
+
+// NewPublicAPI creates an instance of the public Cosmos API.
+func NewPublicAPI(logger log.Logger, backend backend.CosmosBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "cosmos"),
+		backend: backend,
+	}
+}
+
+// HexToBech32 converts the given hex address to its bech32 representation
+// using the account address prefix of the chain.
+func (api *PublicAPI) HexToBech32(address common.Address) string {
+	api.logger.Debug("cosmos_hexToBech32", "address", address.Hex())
+	return utils.EthToCosmosAddr(address).String()
+}
+
+// Bech32ToHex converts the given bech32 address to its hex representation.
+// Addresses with any human readable prefix are accepted.
+func (api *PublicAPI) Bech32ToHex(address string) (common.Address, error) {
+	api.logger.Debug("cosmos_bech32ToHex", "address", address)
+	accAddr, err := utils.GetAccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return utils.CosmosToEthAddr(accAddr), nil
+}
+
+// GetCosmosTxHash returns the hash of the CometBFT transaction that includes
+// the Ethereum transaction identified by the given hash.
+func (api *PublicAPI) GetCosmosTxHash(hash common.Hash) (cmtbytes.HexBytes, error) {
+	api.logger.Debug("cosmos_getCosmosTxHash", "hash", hash.Hex())
+	return api.backend.CosmosTxHashByEthHash(hash)
+}
+
+// GetEthTxHashes returns the hashes of the Ethereum transactions included in
+// the CometBFT transaction identified by the given hash.
+func (api *PublicAPI) GetEthTxHashes(hash string) ([]common.Hash, error) {
+	api.logger.Debug("cosmos_getEthTxHashes", "hash", hash)
+	txHash, err := parseTxHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.EthTxHashesByCosmosHash(txHash)
+}
+
+// GetTx returns the raw CometBFT transaction identified by the given hash,
+// along with the events and the ABCI result code of its execution.
+func (api *PublicAPI) GetTx(hash string) (*rpctypes.CosmosTxResult, error) {
+	api.logger.Debug("cosmos_getTx", "hash", hash)
+	txHash, err := parseTxHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.GetCosmosTx(txHash)
+}
+
+// GetBalances returns the bank balances of all the denominations held by the
+// given account at the given block. The address can be either in hex or in
+// bech32 format.
+func (api *PublicAPI) GetBalances(address string, blockNrOrHash rpctypes.BlockNumberOrHash) (sdk.Coins, error) {
+	api.logger.Debug("cosmos_getBalances", "address", address, "block number or hash", blockNrOrHash)
+
+	var accAddr sdk.AccAddress
+	if common.IsHexAddress(address) {
+		accAddr = utils.EthHexToCosmosAddr(address)
+	} else {
+		var err error
+		if accAddr, err = utils.GetAccAddressFromBech32(address); err != nil {
+			return nil, err
+		}
+	}
+
+	return api.backend.GetCosmosBalances(accAddr, blockNrOrHash)
+}
+
+// parseTxHash decodes a CometBFT transaction hash, with or without the 0x prefix.
+func parseTxHash(hash string) (cmtbytes.HexBytes, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hash %s: %w", hash, err)
+	}
+	if len(bz) != tmhash.Size {
+		return nil, fmt.Errorf("invalid tx hash %s: expected %d bytes, got %d", hash, tmhash.Size, len(bz))
+	}
+	return bz, nil
+}
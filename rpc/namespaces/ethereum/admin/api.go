@@ -0,0 +1,193 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/os/rpc/backend"
+)
+
+// IndexerService is the EVM indexer service, which can be paused and resumed
+// at runtime.
+type IndexerService interface {
+	Pause()
+	Resume()
+	IsPaused() bool
+}
+
+// NodeInfo is the information about the node returned by admin_nodeInfo.
+type NodeInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ListenAddr  string `json:"listenAddr"`
+	Network     string `json:"network"`
+	Version     string `json:"version"`
+	LatestBlock uint64 `json:"latestBlock"`
+	CatchingUp  bool   `json:"catchingUp"`
+}
+
+// PeerInfo is the information about a connected peer returned by
+// admin_peers.
+type PeerInfo struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	RemoteAddr string `json:"remoteAddr"`
+	Inbound    bool   `json:"inbound"`
+	Network    string `json:"network"`
+	Version    string `json:"version"`
+}
+
+// API is the admin prefixed set of APIs, served on the authenticated admin
+// listener only.
+type API struct {
+	logger   log.Logger
+	backend  backend.EVMBackend
+	tmClient rpcclient.Client
+	// tmRPCAddr is the address of the CometBFT RPC server, which serves the
+	// unsafe routes
+	tmRPCAddr string
+	indexer   IndexerService
+}
+
+// NewAPI creates an instance of the admin API. The indexer service is nil if
+// the EVM indexer is disabled.
+func NewAPI(
+	ctx *server.Context,
+	clientCtx client.Context,
+	backend backend.EVMBackend,
+	indexer IndexerService,
+) *API {
+	return &API{
+		logger:    ctx.Logger.With("api", "admin"),
+		backend:   backend,
+		tmClient:  clientCtx.Client.(rpcclient.Client),
+		tmRPCAddr: ctx.Config.RPC.ListenAddress,
+		indexer:   indexer,
+	}
+}
+
+// NodeInfo returns the information about the node.
+func (api *API) NodeInfo() (*NodeInfo, error) {
+	api.logger.Debug("admin_nodeInfo")
+	status, err := api.tmClient.Status(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeInfo{
+		ID:          string(status.NodeInfo.DefaultNodeID),
+		Name:        status.NodeInfo.Moniker,
+		ListenAddr:  status.NodeInfo.ListenAddr,
+		Network:     status.NodeInfo.Network,
+		Version:     status.NodeInfo.Version,
+		LatestBlock: uint64(status.SyncInfo.LatestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
+		CatchingUp:  status.SyncInfo.CatchingUp,
+	}, nil
+}
+
+// Peers returns the information about the connected peers.
+func (api *API) Peers() ([]PeerInfo, error) {
+	api.logger.Debug("admin_peers")
+	netInfo, err := api.tmClient.NetInfo(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, PeerInfo{
+			ID:         string(peer.NodeInfo.DefaultNodeID),
+			Name:       peer.NodeInfo.Moniker,
+			RemoteAddr: peer.RemoteIP,
+			Inbound:    !peer.IsOutbound,
+			Network:    peer.NodeInfo.Network,
+			Version:    peer.NodeInfo.Version,
+		})
+	}
+	return peers, nil
+}
+
+// AddPeer dials the peer of the given address, formatted as id@host:port. It
+// calls the dial_peers route of CometBFT, which requires the unsafe routes to
+// be enabled in its RPC config.
+func (api *API) AddPeer(peer string) (bool, error) {
+	api.logger.Debug("admin_addPeer", "peer", peer)
+	if _, err := p2p.NewNetAddressString(peer); err != nil {
+		return false, fmt.Errorf("invalid peer address %s: %w", peer, err)
+	}
+
+	tmClient, err := jsonrpcclient.New(api.tmRPCAddr)
+	if err != nil {
+		return false, err
+	}
+
+	params := map[string]interface{}{
+		"peers":         []string{peer},
+		"persistent":    false,
+		"unconditional": false,
+		"private":       false,
+	}
+	if _, err := tmClient.Call(context.Background(), "dial_peers", params, &coretypes.ResultDialPeers{}); err != nil {
+		return false, fmt.Errorf("failed to dial peer, the CometBFT unsafe RPC routes must be enabled: %w", err)
+	}
+	return true, nil
+}
+
+// SetGasCap sets the global gas cap of the eth_call variants (0=unlimited).
+func (api *API) SetGasCap(gasCap hexutil.Uint64) bool {
+	api.logger.Info("admin_setGasCap", "gas_cap", uint64(gasCap))
+	api.backend.SetRPCGasCap(uint64(gasCap))
+	return true
+}
+
+// SetEVMTimeout sets the global timeout of the eth_call variants from a
+// duration string such as "5s" (0=infinite).
+func (api *API) SetEVMTimeout(timeout string) (bool, error) {
+	api.logger.Info("admin_setEVMTimeout", "timeout", timeout)
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return false, err
+	}
+	if duration < 0 {
+		return false, errors.New("EVM timeout cannot be negative")
+	}
+
+	api.backend.SetRPCEVMTimeout(duration)
+	return true, nil
+}
+
+// StartIndexer resumes the indexing of the EVM indexer.
+func (api *API) StartIndexer() (bool, error) {
+	api.logger.Info("admin_startIndexer")
+	if api.indexer == nil {
+		return false, errors.New("the EVM indexer is not enabled")
+	}
+
+	api.indexer.Resume()
+	return true, nil
+}
+
+// StopIndexer pauses the indexing of the EVM indexer. The blocks committed
+// while paused are indexed once resumed.
+func (api *API) StopIndexer() (bool, error) {
+	api.logger.Info("admin_stopIndexer")
+	if api.indexer == nil {
+		return false, errors.New("the EVM indexer is not enabled")
+	}
+
+	api.indexer.Pause()
+	return true, nil
+}
@@ -65,6 +65,18 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceTransaction(hash, config)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+	return a.backend.TraceCall(args, blockNrOrHash, config)
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
@@ -325,9 +337,49 @@ func (a *API) SeedHash(number uint64) (string, error) {
 	return fmt.Sprintf("0x%x", ethash.SeedHash(number)), nil
 }
 
-// IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
+// IntermediateRoots executes a block, and returns a list of intermediate roots:
+// a commitment of the state after each transaction. Since the state of the chain
+// is not stored in a Merkle Patricia Trie, each commitment is computed as the
+// hash of the previous one and of the EVM state changes of the transaction.
 func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %s not found", hash.Hex())
+	}
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	return a.backend.IntermediateRoots(resBlock)
+}
+
+// AccountRange enumerates all accounts in the given block and start point in
+// paging request.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (*rpctypes.AccountRangeResult, error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "max results", maxResults)
+	return a.backend.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
+// StorageRangeAt returns the storage at the given block height and transaction
+// index. The storage is iterated in the order of the raw storage keys, starting
+// from keyStart.
+func (a *API) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (*rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress, "key start", keyStart)
+	return a.backend.StorageRangeAt(blockHash, txIndex, contractAddress, keyStart, maxResult)
 }
@@ -45,6 +45,8 @@ type Backend interface {
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	BloomBits(bit uint, section uint64) ([]byte, error)
+	LogTopicHeights(addresses []common.Address, topics0 []common.Hash, from, to int64) ([]int64, int64, error)
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -0,0 +1,99 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package filters
+
+import (
+	"bytes"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// candidateHeights returns the heights in the [from, to] range of the blocks
+// that may contain logs matching the filter. The blocks of the sections indexed
+// in bloom bits are skipped when their bloom cannot match the filter, while the
+// blocks that are not indexed yet are always returned to be checked one by one.
+func (f *Filter) candidateHeights(from, to int64) []int64 {
+	heights := make([]int64, 0)
+
+	sectionSize, sections := f.backend.BloomStatus()
+	size := int64(sectionSize)        //nolint:gosec // G115 // section size won't exceed int64
+	indexed := size * int64(sections) //nolint:gosec // G115 // sections won't exceed int64
+
+	// without filter criteria every block is a candidate
+	if len(f.bloomFilters) == 0 || size == 0 {
+		indexed = 0
+	}
+
+	height := from
+	for ; height <= to && height < indexed; height = (height/size + 1) * size {
+		section := height / size
+		end := min(to, (section+1)*size-1)
+
+		matches, err := f.matchSection(uint64(section), sectionSize) //nolint:gosec // G115 // section is never negative
+		if err != nil {
+			f.logger.Debug("failed to match bloom bits section", "section", section, "error", err.Error())
+			for h := height; h <= end; h++ {
+				heights = append(heights, h)
+			}
+			continue
+		}
+
+		for h := height; h <= end; h++ {
+			i := h - section*size
+			if matches[i/8]&(1<<(7-i%8)) != 0 {
+				heights = append(heights, h)
+			}
+		}
+	}
+
+	for ; height <= to; height++ {
+		heights = append(heights, height)
+	}
+	return heights
+}
+
+// matchSection returns the bit vector of the blocks of an indexed section whose
+// bloom matches all the filter rules.
+func (f *Filter) matchSection(section, sectionSize uint64) ([]byte, error) {
+	// the same bloom bit can be used by several rules
+	cache := make(map[uint][]byte)
+	bloomBits := func(bit uint) ([]byte, error) {
+		if vector, ok := cache[bit]; ok {
+			return vector, nil
+		}
+		vector, err := f.backend.BloomBits(bit, section)
+		if err != nil {
+			return nil, err
+		}
+		cache[bit] = vector
+		return vector, nil
+	}
+
+	matches := bytes.Repeat([]byte{0xff}, int(sectionSize/8)) //nolint:gosec // G115 // section size won't exceed int
+	for _, rule := range f.bloomFilters {
+		// a block matches a rule if its bloom contains any of the rule clauses
+		ruleMatches := make([]byte, len(matches))
+		for _, iv := range rule {
+			clauseMatches := bytes.Repeat([]byte{0xff}, len(matches))
+			for j := range iv.I {
+				vector, err := bloomBits(bloomBitIndex(iv.I[j], iv.V[j]))
+				if err != nil {
+					return nil, err
+				}
+				bitutil.ANDBytes(clauseMatches, clauseMatches, vector)
+			}
+			bitutil.ORBytes(ruleMatches, ruleMatches, clauseMatches)
+		}
+		bitutil.ANDBytes(matches, matches, ruleMatches)
+	}
+	return matches, nil
+}
+
+// bloomBitIndex converts the byte index and bit value of a bloom filter into
+// the index of the bloom bit, as used by geth bloom bits.
+func bloomBitIndex(i uint, v byte) uint {
+	return 8*(ethtypes.BloomByteLength-1-i) + uint(bits.TrailingZeros8(v))
+}
@@ -0,0 +1,170 @@
+package filters
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/os/indexer"
+	"github.com/stretchr/testify/require"
+)
+
+// bloomBitsBackend serves the bloom bits from a KV indexer.
+type bloomBitsBackend struct {
+	Backend
+	idxer *indexer.KVIndexer
+}
+
+func (b bloomBitsBackend) BloomStatus() (uint64, uint64) {
+	sections, _ := b.idxer.BloomSections()
+	return b.idxer.BloomSectionSize(), sections
+}
+
+func (b bloomBitsBackend) BloomBits(bit uint, section uint64) ([]byte, error) {
+	return b.idxer.GetBloomBits(bit, section)
+}
+
+// logTopicBackend serves the log heights of the blocks up to indexedTo from
+// an address index, and the bloom bits of the other blocks.
+type logTopicBackend struct {
+	bloomBitsBackend
+	heights   []int64
+	indexedTo int64
+	err       error
+}
+
+func (b logTopicBackend) LogTopicHeights([]common.Address, []common.Hash, int64, int64) ([]int64, int64, error) {
+	return b.heights, b.indexedTo, b.err
+}
+
+func TestCandidateHeights(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	topic := common.HexToHash("0x01")
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+	blooms := make([]ethtypes.Bloom, indexer.BloomBitsBlocks)
+	blooms[5].Add(addr.Bytes())
+	blooms[5].Add(topic.Bytes())
+	blooms[10].Add(addr.Bytes())
+	blooms[20].Add(other.Bytes())
+	require.NoError(t, idxer.IndexBloomSection(0, blooms))
+
+	size := int64(indexer.BloomBitsBlocks)
+	backend := bloomBitsBackend{idxer: idxer}
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		addresses  []common.Address
+		topics     [][]common.Hash
+		expHeights []int64
+	}{
+		{
+			"indexed blocks matching the address",
+			1, 100,
+			[]common.Address{addr},
+			nil,
+			[]int64{5, 10},
+		},
+		{
+			"indexed blocks matching any of the addresses",
+			1, 100,
+			[]common.Address{addr, other},
+			nil,
+			[]int64{5, 10, 20},
+		},
+		{
+			"indexed blocks matching the address and topic",
+			1, 100,
+			[]common.Address{addr},
+			[][]common.Hash{{topic}},
+			[]int64{5},
+		},
+		{
+			"range limits are applied",
+			6, 15,
+			[]common.Address{addr},
+			nil,
+			[]int64{10},
+		},
+		{
+			"blocks after the indexed sections are always candidates",
+			size - 1, size + 1,
+			[]common.Address{addr},
+			nil,
+			[]int64{size, size + 1},
+		},
+		{
+			"without criteria every block is a candidate",
+			4, 6,
+			nil,
+			nil,
+			[]int64{4, 5, 6},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewRangeFilter(log.NewNopLogger(), backend, tc.from, tc.to, tc.addresses, tc.topics)
+			require.Equal(t, tc.expHeights, f.candidateHeights(tc.from, tc.to))
+		})
+	}
+}
+
+func TestLogHeights(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	topic := common.HexToHash("0x01")
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+	blooms := make([]ethtypes.Bloom, indexer.BloomBitsBlocks)
+	blooms[5].Add(addr.Bytes())
+	blooms[5].Add(topic.Bytes())
+	blooms[10].Add(addr.Bytes())
+	blooms[10].Add(topic.Bytes())
+	require.NoError(t, idxer.IndexBloomSection(0, blooms))
+
+	testCases := []struct {
+		name       string
+		backend    logTopicBackend
+		topics     [][]common.Hash
+		expHeights []int64
+	}{
+		{
+			"address index covers the range",
+			logTopicBackend{heights: []int64{10}, indexedTo: 20},
+			[][]common.Hash{{topic}},
+			[]int64{10},
+		},
+		{
+			"blocks after the address index use the bloom bits",
+			logTopicBackend{heights: []int64{3}, indexedTo: 7},
+			[][]common.Hash{{topic}},
+			[]int64{3, 10},
+		},
+		{
+			"bloom bits without address index",
+			logTopicBackend{err: errors.New("address index is not enabled")},
+			[][]common.Hash{{topic}},
+			[]int64{5, 10},
+		},
+		{
+			"bloom bits without first topic",
+			logTopicBackend{heights: []int64{10}, indexedTo: 20},
+			[][]common.Hash{nil, {topic}},
+			[]int64{5, 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.backend.bloomBitsBackend = bloomBitsBackend{idxer: idxer}
+			f := NewRangeFilter(log.NewNopLogger(), tc.backend, 1, 20, []common.Address{addr}, tc.topics)
+			require.Equal(t, tc.expHeights, f.logHeights(1, 20))
+		})
+	}
+}
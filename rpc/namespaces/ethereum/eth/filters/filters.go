@@ -155,7 +155,7 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
-	for height := from; height <= to; height++ {
+	for _, height := range f.logHeights(from, to) {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
@@ -181,6 +181,23 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// logHeights returns the heights of the blocks in the [from, to] range that may
+// contain logs matching the filter. The address index of the tx indexer is used
+// if the filter has both addresses and first topics, and the bloom bits are
+// used for the other filters and for the blocks that are not indexed yet.
+func (f *Filter) logHeights(from, to int64) []int64 {
+	if len(f.criteria.Addresses) == 0 || len(f.criteria.Topics) == 0 || len(f.criteria.Topics[0]) == 0 {
+		return f.candidateHeights(from, to)
+	}
+
+	heights, indexedTo, err := f.backend.LogTopicHeights(f.criteria.Addresses, f.criteria.Topics[0], from, to)
+	if err != nil {
+		f.logger.Debug("failed to fetch log heights from the address index", "error", err.Error())
+		return f.candidateHeights(from, to)
+	}
+	return append(heights, f.candidateHeights(max(from, indexedTo+1), to)...)
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,133 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ots
+
+import (
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/os/rpc/backend"
+	rpctypes "github.com/evmos/os/rpc/types"
+)
+
+// APILevel is the version of the Otterscan API implemented by the node.
+const APILevel = 8
+
+// PublicAPI offers the Otterscan APIs used by the block explorer. The internal
+// operations are traced with the callTracer on top of the TraceTx query of the
+// EVM module, and the transactions of an address are searched with the address
+// index of the EVM tx indexer.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates a new ots API instance.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the version of the Otterscan API implemented by the node.
+func (api *PublicAPI) GetApiLevel() uint64 { //nolint:revive,stylecheck // the method name is part of the Otterscan API
+	api.logger.Debug("ots_getApiLevel")
+	return APILevel
+}
+
+// GetInternalOperations returns the value transfers, contract creations and
+// self destructs performed by the contracts called by the given transaction.
+func (api *PublicAPI) GetInternalOperations(hash common.Hash) ([]*rpctypes.OtsInternalOperation, error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash)
+	return api.backend.OtsInternalOperations(hash)
+}
+
+// HasCode returns whether the given address holds code at the given block.
+func (api *PublicAPI) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (bool, error) {
+	api.logger.Debug("ots_hasCode", "address", address, "block number or hash", blockNrOrHash)
+	code, err := api.backend.GetCode(address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetTransactionError returns the revert data of the given transaction.
+func (api *PublicAPI) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	api.logger.Debug("ots_getTransactionError", "hash", hash)
+	return api.backend.OtsTransactionError(hash)
+}
+
+// TraceTransaction returns the call frames of the given transaction.
+func (api *PublicAPI) TraceTransaction(hash common.Hash) ([]*rpctypes.OtsTraceEntry, error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash)
+	return api.backend.OtsTraceTransaction(hash)
+}
+
+// SearchTransactionsBefore returns a page of the transactions of the given
+// address before the given block. A block number of 0 returns the most recent
+// transactions.
+func (api *PublicAPI) SearchTransactionsBefore(
+	address common.Address,
+	blockNum uint64,
+	pageSize uint16,
+) (*rpctypes.OtsTransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", address, "number", blockNum, "page size", pageSize)
+	return api.backend.OtsSearchTransactionsBefore(address, blockNum, int(pageSize))
+}
+
+// SearchTransactionsAfter returns a page of the transactions of the given
+// address after the given block. A block number of 0 returns the oldest
+// transactions.
+func (api *PublicAPI) SearchTransactionsAfter(
+	address common.Address,
+	blockNum uint64,
+	pageSize uint16,
+) (*rpctypes.OtsTransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", address, "number", blockNum, "page size", pageSize)
+	return api.backend.OtsSearchTransactionsAfter(address, blockNum, int(pageSize))
+}
+
+// GetContractCreator returns the transaction and the address that created the
+// given contract.
+func (api *PublicAPI) GetContractCreator(address common.Address) (*rpctypes.OtsContractCreator, error) {
+	api.logger.Debug("ots_getContractCreator", "address", address)
+	return api.backend.OtsContractCreator(address)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the given address with the given nonce.
+func (api *PublicAPI) GetTransactionBySenderAndNonce(address common.Address, nonce uint64) (*common.Hash, error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "address", address, "nonce", nonce)
+	return api.backend.OtsTransactionBySenderAndNonce(address, nonce)
+}
+
+// GetBlockDetails returns the header of the given block along with its
+// transaction count and fees.
+func (api *PublicAPI) GetBlockDetails(blockNr rpctypes.BlockNumber) (*rpctypes.OtsBlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetails", "number", blockNr)
+	return api.backend.OtsBlockDetails(blockNr)
+}
+
+// GetBlockDetailsByHash returns the header of the given block along with its
+// transaction count and fees.
+func (api *PublicAPI) GetBlockDetailsByHash(hash common.Hash) (*rpctypes.OtsBlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	blockNr, err := api.backend.BlockNumberFromTendermintByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.OtsBlockDetails(rpctypes.BlockNumber(blockNr.Int64()))
+}
+
+// GetBlockTransactions returns a page of the transactions of the given block
+// with their receipts, counting the pages from the end of the block.
+func (api *PublicAPI) GetBlockTransactions(
+	blockNr rpctypes.BlockNumber,
+	pageNumber, pageSize uint8,
+) (*rpctypes.OtsBlockTransactions, error) {
+	api.logger.Debug("ots_getBlockTransactions", "number", blockNr, "page", pageNumber, "page size", pageSize)
+	return api.backend.OtsBlockTransactions(blockNr, int(pageNumber), int(pageSize))
+}
@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/os/rpc/backend"
+	rpctypes "github.com/evmos/os/rpc/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+// PublicAPI offers the Parity-style trace APIs. The traces are produced by the
+// flatCallTracer, stateDiffTracer and vmTraceTracer native tracers on top of the
+// TraceTx, TraceBlock and TraceCall queries of the EVM module.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates a new trace API instance.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat call traces of all the transactions of the given block.
+func (api *PublicAPI) Block(blockNr rpctypes.BlockNumber) ([]json.RawMessage, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+	block, err := api.getBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.FlatTraceBlock(block)
+}
+
+// Transaction returns the flat call traces of the given transaction.
+func (api *PublicAPI) Transaction(hash common.Hash) ([]json.RawMessage, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	return api.backend.FlatTraceTransaction(hash)
+}
+
+// Filter returns the flat call traces of the block range matching the given
+// filter.
+func (api *PublicAPI) Filter(args rpctypes.TraceFilterArgs) ([]json.RawMessage, error) {
+	api.logger.Debug("trace_filter", "from", args.FromBlock, "to", args.ToBlock)
+	return api.backend.FilterFlatTraces(args)
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the requested traces of each of them.
+func (api *PublicAPI) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+	block, err := api.getBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.ReplayBlockTransactions(block, traceTypes)
+}
+
+// ReplayTransaction replays the given transaction and returns the requested
+// traces.
+func (api *PublicAPI) ReplayTransaction(hash common.Hash, traceTypes []string) (*rpctypes.TraceResults, error) {
+	api.logger.Debug("trace_replayTransaction", "hash", hash, "types", traceTypes)
+	return api.backend.ReplayTransaction(hash, traceTypes)
+}
+
+// Call executes the given call on top of the state of the requested block,
+// the latest one by default, and returns the requested traces.
+func (api *PublicAPI) Call(
+	args evmtypes.TransactionArgs,
+	traceTypes []string,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.TraceResults, error) {
+	api.logger.Debug("trace_call", "args", args.String(), "types", traceTypes)
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		blockNrOrHash = &rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	return api.backend.ReplayCall(args, traceTypes, *blockNrOrHash)
+}
+
+// getBlock returns the CometBFT block of the given number.
+func (api *PublicAPI) getBlock(blockNr rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	if blockNr == rpctypes.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+	block, err := api.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		api.logger.Debug("get block failed", "number", blockNr, "error", err.Error())
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+	return block, nil
+}
@@ -3,23 +3,32 @@
 package txpool
 
 import (
+	"fmt"
+	"math/big"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/os/rpc/backend"
 	"github.com/evmos/os/rpc/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The transactions are read from the CometBFT mempool and split into pending and queued
+// transactions according to the on-chain nonce of their sender.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -30,24 +39,144 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := api.chainID()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, msgs := range pending {
+		dump, err := rpcTxsByNonce(msgs, chainID)
+		if err != nil {
+			return nil, err
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	for account, msgs := range queued {
+		dump, err := rpcTxsByNonce(msgs, chainID)
+		if err != nil {
+			return nil, err
+		}
+		content["queued"][account.Hex()] = dump
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// for the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, queued, err := api.backend.TxPoolContentFrom(address)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := api.chainID()
+	if err != nil {
+		return nil, err
+	}
+
+	pendingDump, err := rpcTxsByNonce(pending, chainID)
+	if err != nil {
+		return nil, err
+	}
+	queuedDump, err := rpcTxsByNonce(queued, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": pendingDump,
+		"queued":  queuedDump,
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, msgs := range pending {
+		content["pending"][account.Hex()] = inspectTxsByNonce(msgs)
+	}
+	for account, msgs := range queued {
+		content["queued"][account.Hex()] = inspectTxsByNonce(msgs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCount, queuedCount int
+	for _, msgs := range pending {
+		pendingCount += len(msgs)
+	}
+	for _, msgs := range queued {
+		queuedCount += len(msgs)
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pendingCount), //#nosec G115 -- int overflow is not a concern here
+		"queued":  hexutil.Uint(queuedCount),  //#nosec G115 -- int overflow is not a concern here
+	}, nil
+}
+
+// chainID returns the EIP-155 chain ID used to format the RPC transactions.
+func (api *PublicAPI) chainID() (*big.Int, error) {
+	chainIDHex, err := api.backend.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	return chainIDHex.ToInt(), nil
+}
+
+// rpcTxsByNonce returns the RPC representation of the given transactions
+// indexed by their nonce.
+func rpcTxsByNonce(msgs []*evmtypes.MsgEthereumTx, chainID *big.Int) (map[string]*types.RPCTransaction, error) {
+	dump := make(map[string]*types.RPCTransaction, len(msgs))
+	for _, msg := range msgs {
+		rpcTx, err := types.NewTransactionFromMsg(msg, common.Hash{}, uint64(0), uint64(0), nil, chainID)
+		if err != nil {
+			return nil, err
+		}
+		dump[fmt.Sprintf("%d", msg.AsTransaction().Nonce())] = rpcTx
+	}
+	return dump, nil
+}
+
+// inspectTxsByNonce returns a summary of the given transactions indexed by
+// their nonce, using the same format as go-ethereum.
+func inspectTxsByNonce(msgs []*evmtypes.MsgEthereumTx) map[string]string {
+	dump := make(map[string]string, len(msgs))
+	for _, msg := range msgs {
+		tx := msg.AsTransaction()
+		if to := tx.To(); to != nil {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		} else {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+		}
 	}
+	return dump
 }
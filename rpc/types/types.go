@@ -4,11 +4,14 @@
 package types
 
 import (
-	"math/big"
+	"encoding/json"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -56,20 +59,219 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// SimOpts is the set of blocks of calls and the options of an eth_simulateV1
+// request.
+type SimOpts = evmtypes.SimOpts
+
+// SimCallResult is the result of a call simulated by eth_simulateV1.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed call simulated by eth_simulateV1.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// TraceCallConfig is the config for the debug_traceCall rpc api. It extends
+// the trace config with the state and block overrides applied to the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+}
+
+// AccessListResult is the result of an eth_createAccessList request. It
+// contains the generated access list, the gas used by the call with the access
+// list applied and the error of the execution, if any.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// SyncingResult is the notification sent to the eth_subscribe("syncing")
+// subscribers whenever the node moves into or out of catch-up.
+type SyncingResult struct {
+	Syncing bool       `json:"syncing"`
+	Status  SyncStatus `json:"status"`
+}
+
+// SyncStatus is the synchronisation progress of the node.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// CosmosTxResult is the result of a cosmos_getTx request. It contains the raw
+// Cosmos transaction along with its ABCI execution result.
+type CosmosTxResult struct {
+	Hash        cmtbytes.HexBytes `json:"hash"`
+	Height      hexutil.Uint64    `json:"height"`
+	Index       hexutil.Uint      `json:"index"`
+	Code        uint32            `json:"code"`
+	Codespace   string            `json:"codespace,omitempty"`
+	Log         string            `json:"log,omitempty"`
+	GasWanted   hexutil.Uint64    `json:"gasWanted"`
+	GasUsed     hexutil.Uint64    `json:"gasUsed"`
+	Events      []abci.Event      `json:"events"`
+	Tx          hexutil.Bytes     `json:"tx"`
+	EthTxHashes []common.Hash     `json:"ethTxHashes"`
+}
+
+// AccountRangeResult is the result of a debug_accountRange request. It follows
+// the go-ethereum state dump format.
+type AccountRangeResult struct {
+	Root     string                         `json:"root"`
+	Accounts map[common.Address]DumpAccount `json:"accounts"`
+	// Next can be set to represent that this dump is only partial, and Next
+	// is where an iterator should be positioned in order to continue the dump.
+	Next hexutil.Bytes `json:"next,omitempty"`
+}
+
+// DumpAccount represents an account in the state returned by debug_accountRange.
+type DumpAccount struct {
+	Balance  string                 `json:"balance"`
+	Nonce    uint64                 `json:"nonce"`
+	CodeHash hexutil.Bytes          `json:"codeHash"`
+	Code     hexutil.Bytes          `json:"code,omitempty"`
+	Storage  map[common.Hash]string `json:"storage,omitempty"`
+	Address  *common.Address        `json:"address,omitempty"`
+}
+
+// StorageRangeResult is the result of a debug_storageRangeAt request. As in
+// go-ethereum, the storage entries are keyed by the hash of the storage key.
+// NextKey is the raw storage key to be used to continue the iteration.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key in the trie.
+}
+
+// StorageMap is the storage returned by debug_storageRangeAt, keyed by the
+// hash of the storage keys.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage slot returned by debug_storageRangeAt.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// Parity trace types of the trace_replay* and trace_call requests.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// TraceFilterArgs is the filter of a trace_filter request. Traces match if
+// they are sent from one of the from addresses and to one of the to addresses,
+// an empty list matching any address.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// TraceResults is the result of replaying a transaction or a call with the
+// requested Parity trace types. The outputs of the trace types that were not
+// requested are left empty.
+type TraceResults struct {
+	Output          hexutil.Bytes     `json:"output"`
+	StateDiff       json.RawMessage   `json:"stateDiff"`
+	Trace           []json.RawMessage `json:"trace"`
+	TransactionHash *common.Hash      `json:"transactionHash,omitempty"`
+	VMTrace         json.RawMessage   `json:"vmTrace"`
+}
+
+// Otterscan internal operation types.
+const (
+	OtsOpTransfer     = 0
+	OtsOpSelfDestruct = 1
+	OtsOpCreate       = 2
+	OtsOpCreate2      = 3
+)
+
+// OtsInternalOperation is a value transfer, contract creation or self destruct
+// performed by a contract, as returned by ots_getInternalOperations.
+type OtsInternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// OtsTraceEntry is a call frame of a transaction as returned by
+// ots_traceTransaction. The value is nil for the calls that cannot transfer
+// value.
+type OtsTraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// OtsContractCreator is the transaction and the address that created a
+// contract, as returned by ots_getContractCreator.
+type OtsContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// OtsTransactionsWithReceipts is a page of the transactions of an address,
+// ordered from the most recent one, as returned by the
+// ots_searchTransactions* requests.
+type OtsTransactionsWithReceipts struct {
+	Txs       []*RPCTransaction        `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
+// OtsBlockDetails is the header of a block with its transaction count and
+// fees, as returned by ots_getBlockDetails.
+type OtsBlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  OtsIssuance            `json:"issuance"`
+	TotalFees hexutil.Big            `json:"totalFees"`
+}
+
+// OtsIssuance is the issuance of a block. There are no block or uncle rewards
+// paid by the EVM, so it is always zero.
+type OtsIssuance struct {
+	BlockReward hexutil.Big `json:"blockReward"`
+	UncleReward hexutil.Big `json:"uncleReward"`
+	Issuance    hexutil.Big `json:"issuance"`
+}
+
+// OtsBlockTransactions is a page of the transactions of a block with their
+// receipts, as returned by ots_getBlockTransactions.
+type OtsBlockTransactions struct {
+	FullBlock map[string]interface{}   `json:"fullblock"`
+	Receipts  []map[string]interface{} `json:"receipts"`
 }
 
 type FeeHistoryResult struct {
@@ -84,9 +286,3 @@ type SignTransactionResult struct {
 	Raw hexutil.Bytes         `json:"raw"`
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
-
-type OneFeeHistory struct {
-	BaseFee, NextBaseFee *big.Int   // base fee for each block
-	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
-	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
-}
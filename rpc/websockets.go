@@ -14,12 +14,15 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"cosmossdk.io/log"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/params"
@@ -66,16 +69,30 @@ type ErrorMessageJSON struct {
 	Message string   `json:"message"`
 }
 
+// syncingPollInterval is the interval at which the node status is polled to
+// serve the syncing subscriptions.
+const syncingPollInterval = time.Second
+
 type websocketsServer struct {
 	rpcAddr  string // listen address of rest-server
 	wsAddr   string // listen address of ws server
 	certFile string
 	keyFile  string
 	api      *pubSubAPI
-	logger   log.Logger
+	guard    *RequestGuard
+	metrics  *RPCMetrics
+	// allowAllOrigins disables the origin check, as enabled-unsafe-cors does for HTTP
+	allowAllOrigins bool
+	logger          log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	guard *RequestGuard,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
@@ -85,7 +102,12 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		guard:    guard,
+		// the websocket server only records the subscription methods it serves
+		metrics: NewRPCMetrics(logger, cfg.JSONRPC.SlowQueryThreshold, []string{"eth_subscribe", "eth_unsubscribe"}),
+		// the origin check of the websocket server follows the CORS config of the HTTP server
+		allowAllOrigins: cfg.API.EnableUnsafeCORS,
+		logger:          logger,
 	}
 }
 
@@ -115,21 +137,31 @@ func (s *websocketsServer) Start() {
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	upgrader := websocket.Upgrader{
-		CheckOrigin: func(_ *http.Request) bool {
-			return true
+		CheckOrigin: func(r *http.Request) bool {
+			return s.allowAllOrigins || s.guard.AllowOrigin(r.Header.Get("Origin"))
 		},
 	}
 
+	// reject the connections with an invalid API key before upgrading them
+	client := clientFromRequest(r)
+	if err := s.guard.authenticate(client); err != nil {
+		writeGuardError(w, err)
+		return
+	}
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		s.logger.Debug("websocket upgrade failed", "error", err.Error())
 		return
 	}
+	if maxSize := s.guard.MaxBodySize(); maxSize > 0 {
+		conn.SetReadLimit(maxSize)
+	}
 
 	s.readLoop(&wsConn{
 		mux:  new(sync.Mutex),
 		conn: conn,
-	})
+	}, client)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -170,7 +202,7 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	return w.conn.ReadMessage()
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
+func (s *websocketsServer) readLoop(wsConn *wsConn, client guardClient) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
 	defer func() {
@@ -189,6 +221,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if err := s.guard.check(client, mb); err != nil {
+			_ = wsConn.WriteJSON(newGuardErrorResponse(err)) // #nosec G703
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -230,20 +267,44 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			continue
 		}
 
+		// the subscriptions are served by the websocket server, the other
+		// calls are recorded by the HTTP server they are forwarded to
+		start := time.Now()
+		recordCall := func(failed bool) {
+			if !s.metrics.enabled() {
+				return
+			}
+			params, _ := json.Marshal(msg["params"]) // #nosec G703
+			s.metrics.record(transportWS, method, params, time.Since(start))
+			if failed {
+				s.metrics.recordError(transportWS, method)
+			}
+		}
+
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				recordCall(true)
 				continue
 			}
 
 			subID := rpc.NewID()
 			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			recordCall(err != nil)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
-			subscriptions[subID] = unsubFn
+
+			// the subscription type is valid once subscribed
+			subType, _ := params[0].(string)
+			gauge := subscriptionGauge(subType)
+			gauge.Inc(1)
+			subscriptions[subID] = func() {
+				unsubFn()
+				gauge.Dec(1)
+			}
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -257,11 +318,13 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		case "eth_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				recordCall(true)
 				continue
 			}
 
 			id, ok := params[0].(string)
 			if !ok {
+				recordCall(true)
 				s.sendErrResponse(wsConn, "invalid parameters")
 				continue
 			}
@@ -272,6 +335,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				delete(subscriptions, subID)
 				unsubFn()
 			}
+			recordCall(false)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -316,6 +380,8 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	// the request has already been checked by the guard of the websocket server
+	s.guard.markInternal(req)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -677,8 +743,120 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// subscribeSyncing polls the CometBFT node status and notifies the subscriber
+// whenever the node moves into or out of catch-up.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT client")
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	unsubFn := func() {
+		once.Do(func() { close(done) })
+	}
+
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		var (
+			syncing       bool
+			startingBlock int64
+		)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			status, err := api.clientCtx.Client.Status(context.Background())
+			if err != nil {
+				api.logger.Debug("failed to fetch node status", "subscription-id", subID, "error", err.Error())
+				continue
+			}
+
+			catchingUp := status.SyncInfo.CatchingUp
+			if catchingUp == syncing {
+				continue
+			}
+			syncing = catchingUp
+
+			latestBlock := status.SyncInfo.LatestBlockHeight
+			if syncing {
+				startingBlock = latestBlock
+			}
+			highestBlock := api.highestBlock(latestBlock)
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result: &types.SyncingResult{
+						Syncing: syncing,
+						Status: types.SyncStatus{
+							StartingBlock: hexutil.Uint64(startingBlock), //nolint:gosec // G115 // won't exceed uint64
+							CurrentBlock:  hexutil.Uint64(latestBlock),   //nolint:gosec // G115 // won't exceed uint64
+							HighestBlock:  hexutil.Uint64(highestBlock),  //nolint:gosec // G115 // won't exceed uint64
+						},
+					},
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close() // #nosec G703
+					}
+				}, api.logger, "closing websocket peer sub")
+				return
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
+// peerRoundState is the part of the consensus state of a peer, as dumped by
+// CometBFT, holding the height the peer is at.
+type peerRoundState struct {
+	RoundState struct {
+		Height int64 `json:"height,string"`
+	} `json:"round_state"`
+}
+
+// highestBlock returns the highest block known by the node, which is the
+// latest block committed by its most advanced peer, or the latest block of the
+// node if none of its peers is ahead.
+func (api *pubSubAPI) highestBlock(latestBlock int64) int64 {
+	networkClient, ok := api.clientCtx.Client.(cmtrpcclient.NetworkClient)
+	if !ok {
+		return latestBlock
+	}
+
+	state, err := networkClient.DumpConsensusState(context.Background())
+	if err != nil {
+		api.logger.Debug("failed to fetch consensus state", "error", err.Error())
+		return latestBlock
+	}
+
+	highest := latestBlock
+	for _, peer := range state.Peers {
+		var peerState peerRoundState
+		if err := json.Unmarshal(peer.PeerState, &peerState); err != nil {
+			continue
+		}
+		// the peer is working on the block after its latest committed block
+		if height := peerState.RoundState.Height - 1; height > highest {
+			highest = height
+		}
+	}
+	return highest
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
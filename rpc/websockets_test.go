@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/os/rpc/backend/mocks"
+	"github.com/evmos/os/rpc/types"
+	"github.com/evmos/os/server/config"
+)
+
+func TestWebsocketsCheckOrigin(t *testing.T) {
+	testCases := []struct {
+		name    string
+		origins []string
+		origin  string
+		expPass bool
+	}{
+		{"empty list allows any origin", nil, "https://any.origin", true},
+		{"allowed origin", []string{"https://app.example.com"}, "https://app.example.com", true},
+		{"denied origin", []string{"https://app.example.com"}, "https://any.origin", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &websocketsServer{
+				guard:  NewRequestGuard(config.JSONRPCConfig{CORSAllowedOrigins: tc.origins}),
+				logger: log.NewNopLogger(),
+			}
+			server := httptest.NewServer(s)
+			defer server.Close()
+
+			url := "ws" + strings.TrimPrefix(server.URL, "http")
+			conn, res, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": []string{tc.origin}})
+			if res != nil {
+				defer res.Body.Close()
+			}
+			if !tc.expPass {
+				require.Error(t, err)
+				require.Equal(t, http.StatusForbidden, res.StatusCode)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+		})
+	}
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	status := func(catchingUp bool, latestBlock int64) *coretypes.ResultStatus {
+		return &coretypes.ResultStatus{
+			SyncInfo: coretypes.SyncInfo{CatchingUp: catchingUp, LatestBlockHeight: latestBlock},
+		}
+	}
+	peer := func(height int64) coretypes.PeerStateInfo {
+		return coretypes.PeerStateInfo{
+			PeerState: json.RawMessage(fmt.Sprintf(`{"round_state":{"height":"%d","round":0}}`, height)),
+		}
+	}
+
+	// the node catches up from block 10 to block 20, which its most advanced
+	// peer has committed
+	cmtClient := mocks.NewClient(t)
+	cmtClient.On("Status", mock.Anything).Return(status(true, 10), nil).Once()
+	cmtClient.On("Status", mock.Anything).Return(status(false, 20), nil)
+	cmtClient.On("DumpConsensusState", mock.Anything).Return(&coretypes.ResultDumpConsensusState{
+		Peers: []coretypes.PeerStateInfo{peer(5), peer(21)},
+	}, nil)
+
+	api := &pubSubAPI{
+		logger:    log.NewNopLogger(),
+		clientCtx: client.Context{}.WithClient(cmtClient),
+	}
+
+	var (
+		mu      sync.Mutex
+		unsubFn func()
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(t, err)
+
+		fn, err := api.subscribeSyncing(&wsConn{conn: conn, mux: new(sync.Mutex)}, rpc.ID("0x1"))
+		require.NoError(t, err)
+		mu.Lock()
+		unsubFn = fn
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	conn, res, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	defer conn.Close()
+	defer func() {
+		mu.Lock()
+		defer mu.Unlock()
+		unsubFn()
+	}()
+
+	readResult := func() types.SyncingResult {
+		var notification struct {
+			Method string `json:"method"`
+			Params struct {
+				Subscription rpc.ID              `json:"subscription"`
+				Result       types.SyncingResult `json:"result"`
+			} `json:"params"`
+		}
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*syncingPollInterval)))
+		require.NoError(t, conn.ReadJSON(&notification))
+		require.Equal(t, "eth_subscription", notification.Method)
+		require.Equal(t, rpc.ID("0x1"), notification.Params.Subscription)
+		return notification.Params.Result
+	}
+
+	syncing := readResult()
+	require.True(t, syncing.Syncing)
+	require.Equal(t, types.SyncStatus{StartingBlock: 10, CurrentBlock: 10, HighestBlock: 20}, syncing.Status)
+
+	synced := readResult()
+	require.False(t, synced.Syncing)
+	require.Equal(t, types.SyncStatus{StartingBlock: 10, CurrentBlock: 20, HighestBlock: 20}, synced.Status)
+}
@@ -0,0 +1,81 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package server
+
+import (
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/evmos/os/rpc"
+	"github.com/evmos/os/rpc/backend"
+	"github.com/evmos/os/rpc/namespaces/ethereum/admin"
+	serverconfig "github.com/evmos/os/server/config"
+	evmostypes "github.com/evmos/os/types"
+)
+
+// AdminNamespace is the namespace served by the admin server.
+const AdminNamespace = "admin"
+
+// StartAdminRPC starts the admin server, which serves the admin namespace to
+// the requests authenticated by a JWT signed with the configured secret. The
+// indexer service is nil if the EVM indexer is disabled.
+func StartAdminRPC(
+	ctx *server.Context,
+	clientCtx client.Context,
+	config *serverconfig.Config,
+	indexer evmostypes.EVMTxIndexer,
+	indexerService admin.IndexerService,
+) (*http.Server, error) {
+	secretPath := config.JSONRPC.AdminJWTSecret
+	if !filepath.IsAbs(secretPath) {
+		secretPath = filepath.Join(ctx.Config.RootDir, secretPath)
+	}
+	secret, generated, err := rpc.ObtainJWTSecret(secretPath)
+	if err != nil {
+		return nil, err
+	}
+	if generated {
+		ctx.Logger.Info("generated JWT secret of the JSON-RPC admin server", "path", secretPath)
+	}
+
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, config.JSONRPC.AllowUnprotectedTxs, indexer)
+	rpcServer := ethrpc.NewServer()
+	if err := rpcServer.RegisterName(AdminNamespace, admin.NewAPI(ctx, clientCtx, evmBackend, indexerService)); err != nil {
+		return nil, err
+	}
+
+	httpSrv := &http.Server{
+		Addr:              config.JSONRPC.AdminAddress,
+		Handler:           rpc.NewJWTHandler(secret, rpcServer),
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := Listen(httpSrv.Addr, config)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		ctx.Logger.Info("Starting JSON-RPC admin server", "address", config.JSONRPC.AdminAddress)
+		if err := httpSrv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			ctx.Logger.Error("failed to start JSON-RPC admin server", "error", err.Error())
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(serverconfig.ServerStartTime): // assume the admin server started successfully
+	}
+	return httpSrv, nil
+}
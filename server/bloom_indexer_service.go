@@ -0,0 +1,127 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/os/rpc/backend"
+	evmostypes "github.com/evmos/os/types"
+)
+
+const (
+	BloomServiceName = "BloomIndexerService"
+
+	// BloomIndexerRetryInterval is the time to wait before checking again
+	// if a new section can be indexed.
+	BloomIndexerRetryInterval = 10 * time.Second
+)
+
+// BloomIndexerService indexes the block blooms in bloom bits sections for the
+// json-rpc log filters.
+type BloomIndexerService struct {
+	service.BaseService
+
+	bloomIdxr evmostypes.BloomIndexer
+	client    rpcclient.Client
+}
+
+// NewBloomIndexerService returns a new service instance.
+func NewBloomIndexerService(
+	bloomIdxr evmostypes.BloomIndexer,
+	client rpcclient.Client,
+) *BloomIndexerService {
+	is := &BloomIndexerService{bloomIdxr: bloomIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, BloomServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by indexing every section once all of
+// its blocks have been committed.
+func (bis *BloomIndexerService) OnStart() error {
+	ctx := context.Background()
+	sectionSize := bis.bloomIdxr.BloomSectionSize()
+
+	for {
+		select {
+		case <-bis.Quit():
+			return nil
+		default:
+		}
+
+		sections, err := bis.bloomIdxr.BloomSections()
+		if err != nil {
+			return err
+		}
+
+		status, err := bis.client.Status(ctx)
+		if err != nil {
+			bis.Logger.Error("failed to fetch node status", "err", err)
+			bis.wait()
+			continue
+		}
+
+		// the section is only complete once its last block is committed
+		lastBlock := (sections+1)*sectionSize - 1
+		if uint64(status.SyncInfo.LatestBlockHeight) < lastBlock { //nolint:gosec // G115 // block height is never negative
+			bis.wait()
+			continue
+		}
+
+		blooms, err := bis.sectionBlooms(ctx, sections, sectionSize, status.SyncInfo.EarliestBlockHeight)
+		if err != nil {
+			bis.Logger.Error("failed to fetch section blooms", "section", sections, "err", err)
+			bis.wait()
+			continue
+		}
+
+		if err := bis.bloomIdxr.IndexBloomSection(sections, blooms); err != nil {
+			bis.Logger.Error("failed to index bloom section", "section", sections, "err", err)
+			bis.wait()
+			continue
+		}
+		bis.Logger.Debug("indexed bloom section", "section", sections)
+	}
+}
+
+// wait blocks for the retry interval or until the service is stopped.
+func (bis *BloomIndexerService) wait() {
+	select {
+	case <-bis.Quit():
+	case <-time.After(BloomIndexerRetryInterval):
+	}
+}
+
+// sectionBlooms returns the blooms of all the blocks in a section. Blocks
+// before the earliest available block have no logs that can be queried, so
+// an empty bloom is used for them.
+func (bis *BloomIndexerService) sectionBlooms(
+	ctx context.Context,
+	section, sectionSize uint64,
+	earliestBlock int64,
+) ([]ethtypes.Bloom, error) {
+	blooms := make([]ethtypes.Bloom, sectionSize)
+	for i := range blooms {
+		height := int64(section*sectionSize) + int64(i) //nolint:gosec // G115 // block height won't exceed int64
+		if height < earliestBlock {
+			continue
+		}
+
+		blockRes, err := bis.client.BlockResults(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+
+		// blocks without a bloom event have no evm logs
+		bloom, err := backend.BlockBloomFromBlockResults(blockRes)
+		if err == nil {
+			blooms[i] = bloom
+		}
+	}
+	return blooms, nil
+}
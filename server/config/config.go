@@ -50,6 +50,13 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultJSONRPCAdminAddress is the default address the JSON-RPC admin server binds to.
+	DefaultJSONRPCAdminAddress = "127.0.0.1:8551"
+
+	// DefaultJSONRPCAdminJWTSecret is the default path of the JWT secret of the JSON-RPC admin server,
+	// relative to the node home directory.
+	DefaultJSONRPCAdminJWTSecret = "config/jwtsecret.hex"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -74,6 +81,12 @@ const (
 	// DefaultFeeHistoryCap is the default cap for total number of blocks that can be fetched
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultGasPriceOracleBlocks is the default number of latest blocks sampled to suggest the gas tip cap
+	DefaultGasPriceOracleBlocks int32 = 20
+
+	// DefaultGasPriceOraclePercentile is the default percentile of the sampled tips suggested as gas tip cap
+	DefaultGasPriceOraclePercentile int32 = 60
+
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
@@ -98,6 +111,15 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultMaxRequestBodySize is the default maximum size in bytes of a json-rpc request body
+	DefaultMaxRequestBodySize int64 = 5 * 1024 * 1024
+
+	// DefaultBatchRequestLimit is the default maximum number of requests in a json-rpc batch
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultIndexerBackend is the default backend of the custom tx indexer
+	DefaultIndexerBackend = "kv"
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 )
@@ -143,6 +165,10 @@ type JSONRPCConfig struct {
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
+	// GasPriceOracleBlocks is the number of latest blocks sampled by the fee oracle to suggest the gas tip cap.
+	GasPriceOracleBlocks int32 `mapstructure:"gpo-blocks"`
+	// GasPriceOraclePercentile is the percentile of the sampled tips suggested by the fee oracle as gas tip cap.
+	GasPriceOraclePercentile int32 `mapstructure:"gpo-percentile"`
 	// Enable defines if the EVM RPC server should be enabled.
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
@@ -161,8 +187,48 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableAddressIndex defines if the custom indexer also indexes the transactions by address
+	// and the logs by emitter address and topic.
+	EnableAddressIndex bool `mapstructure:"enable-address-index"`
+	// AddressIndexRetention defines the number of recent blocks kept in the address index (0=all),
+	// only supported by the kv indexer backend.
+	AddressIndexRetention uint64 `mapstructure:"address-index-retention"`
+	// IndexerBackend defines the backend of the custom indexer, as registered in the indexer package.
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerDSN defines the data source name of the custom indexer database, for the SQL backends.
+	IndexerDSN string `mapstructure:"indexer-dsn"`
+	// RateLimit defines the number of requests per second allowed for each client IP (0=unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst defines the number of requests a client IP can send at once (0=rate-limit).
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// APIKeys defines the API keys accepted by the server, in the X-API-Key header or the apikey query parameter.
+	APIKeys []string `mapstructure:"api-keys"`
+	// RequireAPIKey defines if the requests without API key are rejected.
+	RequireAPIKey bool `mapstructure:"require-api-key"`
+	// APIKeyRateLimit defines the number of requests per second allowed for each API key (0=unlimited).
+	APIKeyRateLimit float64 `mapstructure:"api-key-rate-limit"`
+	// APIKeyRateLimitBurst defines the number of requests an API key can send at once (0=api-key-rate-limit).
+	APIKeyRateLimitBurst int `mapstructure:"api-key-rate-limit-burst"`
+	// AllowedMethods defines the methods served even if they match the denied methods.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the methods that are not served, e.g. "debug_*".
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// CORSAllowedOrigins defines the origins allowed to send cross-origin requests (empty=all).
+	CORSAllowedOrigins []string `mapstructure:"cors-allowed-origins"`
+	// MaxRequestBodySize defines the maximum size in bytes of a request body (0=unlimited).
+	MaxRequestBodySize int64 `mapstructure:"max-request-body-size"`
+	// BatchRequestLimit defines the maximum number of requests in a batch (0=unlimited).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
+	// SlowQueryThreshold defines the duration above which the json-rpc calls are logged (0=disabled).
+	SlowQueryThreshold time.Duration `mapstructure:"slow-query-threshold"`
+	// EnableAdmin defines if the admin namespace is served on the authenticated admin server.
+	EnableAdmin bool `mapstructure:"enable-admin"`
+	// AdminAddress defines the admin server to listen on
+	AdminAddress string `mapstructure:"admin-address"`
+	// AdminJWTSecret defines the path of the file holding the hex encoded JWT secret of the admin server.
+	AdminJWTSecret string `mapstructure:"admin-jwt-secret"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
 }
@@ -199,7 +265,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "cosmos"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -215,6 +281,8 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		TxFeeCap:                 DefaultTxFeeCap,
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
+		GasPriceOracleBlocks:     DefaultGasPriceOracleBlocks,
+		GasPriceOraclePercentile: DefaultGasPriceOraclePercentile,
 		BlockRangeCap:            DefaultBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
@@ -222,7 +290,17 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableAddressIndex:       false,
+		AddressIndexRetention:    0,
+		IndexerBackend:           DefaultIndexerBackend,
+		IndexerDSN:               "",
+		CORSAllowedOrigins:       []string{"*"},
+		MaxRequestBodySize:       DefaultMaxRequestBodySize,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
+		EnableAdmin:              false,
+		AdminAddress:             DefaultJSONRPCAdminAddress,
+		AdminJWTSecret:           DefaultJSONRPCAdminJWTSecret,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
 }
@@ -241,6 +319,14 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC feehistory-cap cannot be negative or 0")
 	}
 
+	if c.GasPriceOracleBlocks <= 0 {
+		return errors.New("JSON-RPC gpo-blocks cannot be negative or 0")
+	}
+
+	if c.GasPriceOraclePercentile < 0 || c.GasPriceOraclePercentile > 100 {
+		return errors.New("JSON-RPC gpo-percentile must be between 0 and 100")
+	}
+
 	if c.TxFeeCap < 0 {
 		return errors.New("JSON-RPC tx fee cap cannot be negative")
 	}
@@ -265,6 +351,56 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.RateLimit < 0 || c.RateLimitBurst < 0 {
+		return errors.New("JSON-RPC rate limit and burst cannot be negative")
+	}
+
+	if c.APIKeyRateLimit < 0 || c.APIKeyRateLimitBurst < 0 {
+		return errors.New("JSON-RPC API key rate limit and burst cannot be negative")
+	}
+
+	if c.RequireAPIKey && len(c.APIKeys) == 0 {
+		return errors.New("JSON-RPC cannot require an API key without defining any API key")
+	}
+
+	for _, key := range c.APIKeys {
+		if key == "" {
+			return errors.New("JSON-RPC API keys cannot be empty")
+		}
+	}
+
+	if c.MaxRequestBodySize < 0 {
+		return errors.New("JSON-RPC max request body size cannot be negative")
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.SlowQueryThreshold < 0 {
+		return errors.New("JSON-RPC slow query threshold cannot be negative")
+	}
+
+	if c.EnableAdmin && c.AdminAddress == "" {
+		return errors.New("JSON-RPC admin server address cannot be empty")
+	}
+
+	if c.EnableAdmin && (c.AdminAddress == c.Address || c.AdminAddress == c.WsAddress) {
+		return errors.New("JSON-RPC admin server address must differ from the HTTP and WebSocket server addresses")
+	}
+
+	if c.EnableAdmin && c.AdminJWTSecret == "" {
+		return errors.New("JSON-RPC admin server JWT secret path cannot be empty")
+	}
+
+	if c.EnableAddressIndex && !c.EnableIndexer {
+		return errors.New("JSON-RPC address index requires the custom indexer to be enabled")
+	}
+
+	if c.EnableIndexer && c.IndexerBackend == "" {
+		return errors.New("JSON-RPC indexer backend cannot be empty when the custom indexer is enabled")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package server
+
+import (
+	"context"
+
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/evmos/os/rpc/backend"
+)
+
+const FeeOracleServiceName = "FeeOracleService"
+
+// FeeOracleService follows the new blocks to cache their fee data for the
+// fee queries of the json-rpc service.
+type FeeOracleService struct {
+	service.BaseService
+
+	backend *backend.Backend
+	cancel  context.CancelFunc
+}
+
+// NewFeeOracleService returns a new service instance.
+func NewFeeOracleService(backend *backend.Backend) *FeeOracleService {
+	fos := &FeeOracleService{backend: backend}
+	fos.BaseService = *service.NewBaseService(nil, FeeOracleServiceName, fos)
+	return fos
+}
+
+// OnStart implements service.Service by subscribing for new blocks.
+func (fos *FeeOracleService) OnStart() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := fos.backend.StartFeeOracle(ctx); err != nil {
+		cancel()
+		return err
+	}
+	fos.cancel = cancel
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing from the new blocks.
+func (fos *FeeOracleService) OnStop() {
+	fos.cancel()
+}
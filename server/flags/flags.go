@@ -53,6 +53,26 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	// JSONRPCGasPriceOracleBlocks sets the number of blocks sampled by the fee oracle.
+	JSONRPCGasPriceOracleBlocks     = "json-rpc.gpo-blocks"
+	JSONRPCGasPriceOraclePercentile = "json-rpc.gpo-percentile"
+	// JSONRPCRateLimit sets the number of requests per second allowed for each client IP.
+	JSONRPCRateLimit          = "json-rpc.rate-limit"
+	JSONRPCRateLimitBurst     = "json-rpc.rate-limit-burst"
+	JSONRPCMaxRequestBodySize = "json-rpc.max-request-body-size"
+	JSONRPCBatchRequestLimit  = "json-rpc.batch-request-limit"
+	// JSONRPCSlowQueryThreshold sets the duration above which the json-rpc calls are logged.
+	JSONRPCSlowQueryThreshold = "json-rpc.slow-query-threshold"
+	// JSONRPCEnableAdmin enables the admin namespace on the authenticated admin server.
+	JSONRPCEnableAdmin    = "json-rpc.enable-admin"
+	JSONRPCAdminAddress   = "json-rpc.admin-address"
+	JSONRPCAdminJWTSecret = "json-rpc.admin-jwt-secret"
+	// JSONRPCEnableAddressIndex enables the address index of the custom tx indexer.
+	JSONRPCEnableAddressIndex    = "json-rpc.enable-address-index"
+	JSONRPCAddressIndexRetention = "json-rpc.address-index-retention"
+	// JSONRPCIndexerBackend selects the backend of the custom tx indexer.
+	JSONRPCIndexerBackend = "json-rpc.indexer-backend"
+	JSONRPCIndexerDSN     = "json-rpc.indexer-dsn"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -11,7 +11,8 @@ import (
 	cmtstore "github.com/cometbft/cometbft/store"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
-	"github.com/evmos/os/indexer"
+	evmosserverconfig "github.com/evmos/os/server/config"
+	srvflags "github.com/evmos/os/server/flags"
 	"github.com/spf13/cobra"
 )
 
@@ -26,6 +27,8 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The txs are indexed in the backend selected by the json-rpc config, e.g. --json-rpc.indexer-backend postgres --json-rpc.indexer-dsn <dsn>.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -41,14 +44,16 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			cfg := serverCtx.Config
-			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			config, err := evmosserverconfig.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			idxer, err := OpenEVMTxIndexer(serverCtx, clientCtx, config.JSONRPC, logger.With("module", "evmindex"))
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				logger.Error("failed to open evm indexer", "backend", config.JSONRPC.IndexerBackend, "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -117,5 +122,7 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, evmosserverconfig.DefaultIndexerBackend, "Sets the backend of the custom tx indexer")
+	cmd.Flags().String(srvflags.JSONRPCIndexerDSN, "", "Sets the data source name of the custom tx indexer database, for the SQL backends")
 	return cmd
 }
@@ -5,6 +5,7 @@ package server
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/cometbft/cometbft/libs/service"
@@ -25,6 +26,9 @@ type EVMIndexerService struct {
 
 	txIdxr evmostypes.EVMTxIndexer
 	client rpcclient.Client
+	// paused stops the indexing of the new blocks, which are indexed once
+	// the service is resumed
+	paused atomic.Bool
 }
 
 // NewEVMIndexerService returns a new service instance.
@@ -37,6 +41,21 @@ func NewEVMIndexerService(
 	return is
 }
 
+// Pause stops indexing the new blocks.
+func (eis *EVMIndexerService) Pause() {
+	eis.paused.Store(true)
+}
+
+// Resume resumes indexing, starting from the blocks committed while paused.
+func (eis *EVMIndexerService) Resume() {
+	eis.paused.Store(false)
+}
+
+// IsPaused returns true if the indexing is paused.
+func (eis *EVMIndexerService) IsPaused() bool {
+	return eis.paused.Load()
+}
+
 // OnStart implements service.Service by subscribing for new blocks
 // and indexing them by events.
 func (eis *EVMIndexerService) OnStart() error {
@@ -83,15 +102,15 @@ func (eis *EVMIndexerService) OnStart() error {
 		lastBlock = latestBlock
 	}
 	for {
-		if latestBlock <= lastBlock {
-			// nothing to index. wait for signal of new block
+		if latestBlock <= lastBlock || eis.IsPaused() {
+			// nothing to index or paused. wait for signal of new block
 			select {
 			case <-newBlockSignal:
 			case <-time.After(NewBlockWaitTimeout):
 			}
 			continue
 		}
-		for i := lastBlock + 1; i <= latestBlock; i++ {
+		for i := lastBlock + 1; i <= latestBlock && !eis.IsPaused(); i++ {
 			block, err := eis.client.Block(ctx, &i)
 			if err != nil {
 				eis.Logger.Error("failed to fetch block", "height", i, "err", err)
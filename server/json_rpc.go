@@ -59,10 +59,18 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	// the access controls are shared by the HTTP and websocket servers
+	guard := rpc.NewRequestGuard(config.JSONRPC)
+	rpcMetrics := rpc.NewRPCMetrics(ctx.Logger, config.JSONRPC.SlowQueryThreshold, rpc.APIMethods(apis))
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", guard.Handler(rpcMetrics.Handler(rpcServer))).Methods("POST")
 
-	handlerWithCors := cors.Default()
+	handlerWithCors := cors.New(cors.Options{
+		AllowedOrigins: guard.CORSOrigins(),
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodHead},
+		AllowedHeaders: []string{"Origin", "Accept", "Content-Type", "X-Requested-With", rpc.APIKeyHeader},
+	})
 	if config.API.EnableUnsafeCORS {
 		handlerWithCors = cors.AllowAll()
 	}
@@ -107,7 +115,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, guard)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -12,9 +12,11 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"slices"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	abciserver "github.com/cometbft/cometbft/abci/server"
 	tcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
@@ -42,6 +44,9 @@ import (
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/evmos/os/cmd/config"
 	"github.com/evmos/os/indexer"
+	"github.com/evmos/os/rpc"
+	"github.com/evmos/os/rpc/backend"
+	"github.com/evmos/os/rpc/namespaces/ethereum/admin"
 	ethdebug "github.com/evmos/os/rpc/namespaces/ethereum/debug"
 	evmosserverconfig "github.com/evmos/os/server/config"
 	srvflags "github.com/evmos/os/server/flags"
@@ -189,6 +194,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, evmosserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, evmosserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, evmosserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
+	cmd.Flags().Int32(srvflags.JSONRPCGasPriceOracleBlocks, evmosserverconfig.DefaultGasPriceOracleBlocks, "Sets the number of latest blocks sampled to suggest the gas tip cap")
+	cmd.Flags().Int32(srvflags.JSONRPCGasPriceOraclePercentile, evmosserverconfig.DefaultGasPriceOraclePercentile, "Sets the percentile of the sampled tips suggested as gas tip cap") //nolint:lll
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, evmosserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, evmosserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, evmosserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
@@ -196,7 +203,19 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, evmosserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, evmosserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, evmosserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, 0, "Sets the number of requests per second allowed for each client IP (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, 0, "Sets the number of requests a client IP can send at once (0=rate-limit)")
+	cmd.Flags().Int64(srvflags.JSONRPCMaxRequestBodySize, evmosserverconfig.DefaultMaxRequestBodySize, "Sets the maximum size in bytes of a json-rpc request body (0=unlimited)") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, evmosserverconfig.DefaultBatchRequestLimit, "Sets the maximum number of requests in a json-rpc batch (0=unlimited)")
+	cmd.Flags().Duration(srvflags.JSONRPCSlowQueryThreshold, 0, "Sets the duration above which the json-rpc calls are logged (0=disabled)")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAdmin, false, "Enable the admin namespace on the JWT authenticated admin server")
+	cmd.Flags().String(srvflags.JSONRPCAdminAddress, evmosserverconfig.DefaultJSONRPCAdminAddress, "the JSON-RPC admin server address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCAdminJWTSecret, evmosserverconfig.DefaultJSONRPCAdminJWTSecret, "Sets the path of the JWT secret file of the admin server") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndex, false, "Enable the address index of the custom tx indexer for json-rpc")
+	cmd.Flags().Uint64(srvflags.JSONRPCAddressIndexRetention, 0, "Sets the number of recent blocks kept in the address index (0=all)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, evmosserverconfig.DefaultIndexerBackend, "Sets the backend of the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCIndexerDSN, "", "Sets the data source name of the custom tx indexer database, for the SQL backends")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, evmosserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -417,22 +436,34 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
 	}
 
-	var idxer evmostypes.EVMTxIndexer
+	var (
+		idxer          evmostypes.EVMTxIndexer
+		indexerService *EVMIndexerService
+	)
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = OpenEVMTxIndexer(svrCtx, clientCtx, config.JSONRPC, idxLogger)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			logger.Error("failed to open evm indexer", "backend", config.JSONRPC.IndexerBackend, "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
+		indexerService = NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
 		g.Go(func() error {
 			return indexerService.Start()
 		})
+
+		// only some backends index the block blooms for the log filters
+		if bloomIdxer, ok := idxer.(evmostypes.BloomIndexer); ok {
+			bloomLogger := svrCtx.Logger.With("indexer", "bloom")
+			bloomIndexerService := NewBloomIndexerService(bloomIdxer, clientCtx.Client.(rpcclient.Client))
+			bloomIndexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: bloomLogger})
+
+			g.Go(func() error {
+				return bloomIndexerService.Start()
+			})
+		}
 	}
 
 	if config.API.Enable || config.JSONRPC.Enable {
@@ -477,6 +508,26 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}()
 	}
 
+	if feeOracleService := startFeeOracle(svrCtx, clientCtx, config, idxer); feeOracleService != nil {
+		defer func() {
+			_ = feeOracleService.Stop()
+		}()
+	}
+
+	if config.JSONRPC.Enable && config.JSONRPC.EnableAdmin {
+		// the admin namespace can't pause a disabled indexer
+		var adminIndexerService admin.IndexerService
+		if indexerService != nil {
+			adminIndexerService = indexerService
+		}
+
+		adminSrv, err := StartAdminRPC(svrCtx, clientCtx, &config, idxer, adminIndexerService)
+		if err != nil {
+			return err
+		}
+		defer adminSrv.Close()
+	}
+
 	// At this point it is safe to block the process if we're in query only mode as
 	// we do not need to start Rosetta or handle any CometBFT related processes.
 	if gRPCOnly {
@@ -492,8 +543,25 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 // OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
 func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
-	dataDir := filepath.Join(rootDir, "data")
-	return dbm.NewDB("evmindexer", backendType, dataDir)
+	return indexer.OpenKVIndexerDB(rootDir, backendType)
+}
+
+// OpenEVMTxIndexer creates the custom eth indexer of the backend selected in the json-rpc config
+func OpenEVMTxIndexer(
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	cfg evmosserverconfig.JSONRPCConfig,
+	logger log.Logger,
+) (evmostypes.EVMTxIndexer, error) {
+	return indexer.NewEVMTxIndexer(cfg.IndexerBackend, indexer.BackendOptions{
+		HomeDir:               svrCtx.Config.RootDir,
+		DBBackend:             server.GetAppDBBackend(svrCtx.Viper),
+		DSN:                   cfg.IndexerDSN,
+		AddressIndex:          cfg.EnableAddressIndex,
+		AddressIndexRetention: cfg.AddressIndexRetention,
+		Logger:                logger,
+		ClientCtx:             clientCtx,
+	})
 }
 
 // openTraceWriter opens a trace writer if a trace store file is specified.
@@ -660,6 +728,35 @@ func startJSONRPCServer(
 	return
 }
 
+// startFeeOracle starts the fee oracle caching the fee data of the latest blocks
+// for the eth namespace of the JSON-RPC server. It returns nil if the eth
+// namespace is not served or the fee oracle failed to start, in which case the
+// fee queries fetch the blocks on demand.
+// Parameters:
+// - svrCtx: The server context shared with the JSON-RPC backends.
+// - clientCtx: The client context, including the chain ID.
+// - config: The server configuration that specifies the served JSON-RPC namespaces.
+// - idxer: The EVM transaction indexer for indexing transactions.
+func startFeeOracle(
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	config evmosserverconfig.Config,
+	idxer evmostypes.EVMTxIndexer,
+) *FeeOracleService {
+	if !config.JSONRPC.Enable || !slices.Contains(config.JSONRPC.API, rpc.EthNamespace) {
+		return nil
+	}
+
+	evmBackend := backend.NewBackend(svrCtx, svrCtx.Logger, clientCtx, config.JSONRPC.AllowUnprotectedTxs, idxer)
+	feeOracleService := NewFeeOracleService(evmBackend)
+	feeOracleService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger.With("service", "fee-oracle")})
+	if err := feeOracleService.Start(); err != nil {
+		svrCtx.Logger.Error("failed to start the fee oracle", "error", err.Error())
+		return nil
+	}
+	return feeOracleService
+}
+
 // GenDocProvider returns a function which returns the genesis doc from the genesis file.
 func GenDocProvider(cfg *cmtcfg.Config) func() (*cmttypes.GenesisDoc, error) {
 	return func() (*cmttypes.GenesisDoc, error) {
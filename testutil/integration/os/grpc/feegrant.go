@@ -0,0 +1,26 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package grpc
+
+import (
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFeeAllowance returns the fee allowance from the granter to the grantee.
+func (gqh *IntegrationHandler) GetFeeAllowance(granter, grantee sdk.AccAddress) (*feegrant.QueryAllowanceResponse, error) {
+	feeGrantClient := gqh.network.GetFeeGrantClient()
+	return feeGrantClient.Allowance(gqh.network.GetContext(), &feegrant.QueryAllowanceRequest{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+	})
+}
+
+// GetFeeAllowances returns all the fee allowances received by the grantee.
+func (gqh *IntegrationHandler) GetFeeAllowances(grantee sdk.AccAddress) (*feegrant.QueryAllowancesResponse, error) {
+	feeGrantClient := gqh.network.GetFeeGrantClient()
+	return feeGrantClient.Allowances(gqh.network.GetContext(), &feegrant.QueryAllowancesRequest{
+		Grantee: grantee.String(),
+	})
+}
@@ -4,6 +4,7 @@
 package grpc
 
 import (
+	"cosmossdk.io/x/feegrant"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/common"
@@ -32,6 +33,10 @@ type Handler interface {
 	// Gov methods
 	GetProposal(proposalID uint64) (*govtypes.QueryProposalResponse, error)
 	GetGovParams(paramsType string) (*govtypes.QueryParamsResponse, error)
+
+	// FeeGrant methods
+	GetFeeAllowance(granter, grantee sdk.AccAddress) (*feegrant.QueryAllowanceResponse, error)
+	GetFeeAllowances(grantee sdk.AccAddress) (*feegrant.QueryAllowancesResponse, error)
 }
 
 var _ Handler = (*IntegrationHandler)(nil)
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"cosmossdk.io/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
@@ -91,3 +92,9 @@ func (n *IntegrationNetwork) GetMintClient() minttypes.QueryClient {
 	minttypes.RegisterQueryServer(queryHelper, mintkeeper.NewQueryServerImpl(n.app.MintKeeper))
 	return minttypes.NewQueryClient(queryHelper)
 }
+
+func (n *IntegrationNetwork) GetFeeGrantClient() feegrant.QueryClient {
+	queryHelper := getQueryHelper(n.GetContext(), n.GetEncodingConfig())
+	feegrant.RegisterQueryServer(queryHelper, n.app.FeeGrantKeeper)
+	return feegrant.NewQueryClient(queryHelper)
+}
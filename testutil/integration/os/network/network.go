@@ -10,6 +10,7 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -48,6 +49,7 @@ type Network interface {
 	GetGovClient() govtypes.QueryClient
 	GetFeeMarketClient() feemarkettypes.QueryClient
 	GetMintClient() minttypes.QueryClient
+	GetFeeGrantClient() feegrant.QueryClient
 }
 
 var _ Network = (*IntegrationNetwork)(nil)
@@ -7,12 +7,15 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
 
 	// GetByTxHash returns nil if tx not found.
@@ -20,3 +23,33 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// BloomIndexer defines the interface of the indexer that stores the block
+// blooms rotated into bloom bits, in sections of consecutive blocks.
+type BloomIndexer interface {
+	// BloomSectionSize returns the number of blocks in a section.
+	BloomSectionSize() uint64
+	// BloomSections returns the number of fully indexed sections.
+	BloomSections() (uint64, error)
+	// IndexBloomSection stores the bloom bits of a section, built from the
+	// blooms of all its blocks. Sections have to be indexed in order.
+	IndexBloomSection(section uint64, blooms []ethtypes.Bloom) error
+
+	// GetBloomBits returns the bit vector of a bloom bit in a section.
+	GetBloomBits(bit uint, section uint64) ([]byte, error)
+}
+
+// AddressIndexer defines the interface of the indexer that also indexes the eth
+// txs by the addresses they touch.
+type AddressIndexer interface {
+	// FirstAddressIndexedBlock returns the first block indexed by address,
+	// returns -1 if none is. It is after the first indexed block when the
+	// address index was enabled on an existing indexer db.
+	FirstAddressIndexedBlock() (int64, error)
+	// GetByAddress returns the hashes of the eth txs sent from, sent to or
+	// creating the address within the given block range.
+	GetByAddress(addr common.Address, fromBlock, toBlock int64) ([]common.Hash, error)
+	// GetByLogTopic returns the hashes of the eth txs with logs emitted by the
+	// address with the given first topic within the given block range.
+	GetByLogTopic(addr common.Address, topic0 common.Hash, fromBlock, toBlock int64) ([]common.Hash, error)
+}
@@ -0,0 +1,251 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/os/x/evm/core/tracers"
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+func init() {
+	register("stateDiffTracer", newStateDiffTracer)
+}
+
+// stateDiff is the Parity-style difference between the state of the accounts
+// before and after the transaction.
+type (
+	stateDiff        = map[common.Address]*stateDiffAccount
+	stateDiffAccount struct {
+		Balance interface{}                 `json:"balance"`
+		Code    interface{}                 `json:"code"`
+		Nonce   interface{}                 `json:"nonce"`
+		Storage map[common.Hash]interface{} `json:"storage"`
+	}
+)
+
+// stateDiffTracer collects the state of the accounts touched by a transaction
+// before its execution and reports how it changed in the Parity stateDiff
+// format. Unchanged fields are reported as "=", created ones as {"+": value},
+// removed ones as {"-": value} and modified ones as {"*": {"from", "to"}}.
+//
+// Only the changes performed by the EVM execution are reported, fee payments
+// and nonce increments of calls happen in the ante handler and are not part
+// of the trace.
+type stateDiffTracer struct {
+	env       *vm.EVM
+	pre       prestate
+	born      map[common.Address]bool // Accounts that did not exist before the tx
+	diff      stateDiff
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newStateDiffTracer returns a native go tracer which reports the state
+// changes of a tx, and implements vm.EVMLogger.
+func newStateDiffTracer(_ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &stateDiffTracer{
+		pre:  prestate{},
+		born: make(map[common.Address]bool),
+		diff: stateDiff{},
+	}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *stateDiffTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+
+	t.lookupAccount(from)
+	t.lookupAccount(to)
+
+	// The value has already been transferred when the tracing starts.
+	if from != to && value != nil {
+		toBal := hexutil.MustDecodeBig(t.pre[to].Balance)
+		t.pre[to].Balance = hexutil.EncodeBig(new(big.Int).Sub(toBal, value))
+
+		fromBal := hexutil.MustDecodeBig(t.pre[from].Balance)
+		t.pre[from].Balance = hexutil.EncodeBig(new(big.Int).Add(fromBal, value))
+	}
+
+	if create {
+		// The sender nonce has already been increased by the contract creation.
+		t.pre[from].Nonce--
+		t.born[to] = true
+		return
+	}
+
+	// The recipient of a value transfer is created on the fly if it did not exist.
+	if isEmptyAccount(t.pre[to]) {
+		t.born[to] = true
+	}
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *stateDiffTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *stateDiffTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	stackData := scope.Stack.Data
+	stackLen := len(stackData)
+	switch {
+	case stackLen >= 1 && op == vm.SSTORE:
+		slot := common.Hash(stackData[stackLen-1].Bytes32())
+		t.lookupStorage(scope.Contract.Address(), slot)
+	case stackLen >= 1 && op == vm.SELFDESTRUCT:
+		addr := common.Address(stackData[stackLen-1].Bytes20())
+		t.lookupAccount(addr)
+	case stackLen >= 3 && (op == vm.CALL || op == vm.CALLCODE):
+		addr := common.Address(stackData[stackLen-2].Bytes20())
+		t.lookupAccount(addr)
+	case op == vm.CREATE:
+		addr := scope.Contract.Address()
+		nonce := t.env.StateDB.GetNonce(addr)
+		t.lookupAccount(crypto.CreateAddress(addr, nonce))
+	case stackLen >= 4 && op == vm.CREATE2:
+		offset := stackData[stackLen-2]
+		size := stackData[stackLen-3]
+		init := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64())) //#nosec G115 -- memory offsets fit in int64
+		inithash := crypto.Keccak256(init)
+		salt := stackData[stackLen-4]
+		t.lookupAccount(crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash))
+	}
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *stateDiffTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *stateDiffTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *stateDiffTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
+func (t *stateDiffTracer) CaptureTxStart(gasLimit uint64) {}
+
+// CaptureTxEnd compares the collected state with the state after the
+// execution of the tx.
+func (t *stateDiffTracer) CaptureTxEnd(restGas uint64) {
+	if t.env == nil {
+		return
+	}
+	stateDB := t.env.StateDB
+
+	for addr, pre := range t.pre {
+		born := t.born[addr]
+		died := stateDB.HasSuicided(addr) || !stateDB.Exist(addr)
+		if born && died {
+			continue
+		}
+
+		post := &account{
+			Balance: bigToHex(stateDB.GetBalance(addr)),
+			Nonce:   stateDB.GetNonce(addr),
+			Code:    bytesToHex(stateDB.GetCode(addr)),
+		}
+		if born && isEmptyAccount(post) {
+			continue
+		}
+
+		diff := &stateDiffAccount{
+			Balance: diffValue(pre.Balance, post.Balance, born, died),
+			Code:    diffValue(pre.Code, post.Code, born, died),
+			Nonce:   diffValue(hexutil.EncodeUint64(pre.Nonce), hexutil.EncodeUint64(post.Nonce), born, died),
+			Storage: make(map[common.Hash]interface{}),
+		}
+
+		changed := born || died || diff.Balance != "=" || diff.Code != "=" || diff.Nonce != "="
+		for key, preVal := range pre.Storage {
+			postVal := stateDB.GetState(addr, key)
+			switch {
+			case born && postVal == (common.Hash{}),
+				died && preVal == (common.Hash{}),
+				!born && !died && preVal == postVal:
+				continue
+			}
+			diff.Storage[key] = diffValue(preVal.Hex(), postVal.Hex(), born, died)
+			changed = true
+		}
+
+		if changed {
+			t.diff[addr] = diff
+		}
+	}
+}
+
+// GetResult returns the json-encoded state diff, and any error arising from
+// the encoding or forceful termination (via `Stop`).
+func (t *stateDiffTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.diff)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *stateDiffTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// lookupAccount fetches details of an account and adds it to the pre state
+// if it doesn't exist there.
+func (t *stateDiffTracer) lookupAccount(addr common.Address) {
+	if _, ok := t.pre[addr]; ok {
+		return
+	}
+	t.born[addr] = !t.env.StateDB.Exist(addr)
+	t.pre[addr] = &account{
+		Balance: bigToHex(t.env.StateDB.GetBalance(addr)),
+		Nonce:   t.env.StateDB.GetNonce(addr),
+		Code:    bytesToHex(t.env.StateDB.GetCode(addr)),
+		Storage: make(map[common.Hash]common.Hash),
+	}
+}
+
+// lookupStorage fetches the requested storage slot and adds it to the pre
+// state of the given contract.
+func (t *stateDiffTracer) lookupStorage(addr common.Address, key common.Hash) {
+	t.lookupAccount(addr)
+	if _, ok := t.pre[addr].Storage[key]; ok {
+		return
+	}
+	t.pre[addr].Storage[key] = t.env.StateDB.GetState(addr, key)
+}
+
+// isEmptyAccount returns whether the account has no balance, nonce nor code.
+func isEmptyAccount(acc *account) bool {
+	return acc.Nonce == 0 && acc.Balance == "0x0" && acc.Code == "0x"
+}
+
+// diffValue returns the Parity representation of the change of a value.
+func diffValue(pre, post string, born, died bool) interface{} {
+	switch {
+	case born:
+		return map[string]string{"+": post}
+	case died:
+		return map[string]string{"-": pre}
+	case pre == post:
+		return "="
+	default:
+		return map[string]map[string]string{"*": {"from": pre, "to": post}}
+	}
+}
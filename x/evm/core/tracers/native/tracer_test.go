@@ -0,0 +1,173 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package native_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	evmoscore "github.com/evmos/os/x/evm/core/core"
+	"github.com/evmos/os/x/evm/core/tracers"
+	_ "github.com/evmos/os/x/evm/core/tracers/native"
+	"github.com/evmos/os/x/evm/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// traceContext defines the block and the position of the traced tx.
+type traceContext struct {
+	Number   math.HexOrDecimal64 `json:"number"`
+	Hash     common.Hash         `json:"hash"`
+	Time     math.HexOrDecimal64 `json:"timestamp"`
+	GasLimit math.HexOrDecimal64 `json:"gasLimit"`
+	Miner    common.Address      `json:"miner"`
+	TxHash   common.Hash         `json:"txHash"`
+	TxIndex  int                 `json:"txIndex"`
+}
+
+// traceInput defines the message of the traced tx, a contract is created when
+// the recipient is not set.
+type traceInput struct {
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Value *hexutil.Big    `json:"value"`
+	Gas   hexutil.Uint64  `json:"gas"`
+	Input hexutil.Bytes   `json:"input"`
+}
+
+// traceTest defines a single test to check the result of a tracer against,
+// in the format of the go-ethereum tracer fixtures.
+type traceTest struct {
+	Genesis struct {
+		Alloc core.GenesisAlloc `json:"alloc"`
+	} `json:"genesis"`
+	Context      traceContext    `json:"context"`
+	Input        traceInput      `json:"input"`
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+	Result       json.RawMessage `json:"result"`
+}
+
+func TestFlatCallTracer(t *testing.T) {
+	testTracer(t, "flatCallTracer", "flat_call_tracer")
+}
+
+func TestStateDiffTracer(t *testing.T) {
+	testTracer(t, "stateDiffTracer", "state_diff_tracer")
+}
+
+func TestVMTraceTracer(t *testing.T) {
+	testTracer(t, "vmTraceTracer", "vm_trace_tracer")
+}
+
+// testTracer runs the tracer on every fixture of the testdata directory and
+// checks its result.
+func testTracer(t *testing.T, tracerName string, dirPath string) {
+	t.Helper()
+	files, err := os.ReadDir(filepath.Join("testdata", dirPath))
+	require.NoError(t, err)
+
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		t.Run(strings.TrimSuffix(file.Name(), ".json"), func(t *testing.T) {
+			blob, err := os.ReadFile(filepath.Join("testdata", dirPath, file.Name()))
+			require.NoError(t, err)
+			test := new(traceTest)
+			require.NoError(t, json.Unmarshal(blob, test))
+
+			tracer, err := tracers.New(tracerName, &tracers.Context{
+				BlockHash:   test.Context.Hash,
+				BlockNumber: new(big.Int).SetUint64(uint64(test.Context.Number)),
+				TxIndex:     test.Context.TxIndex,
+				TxHash:      test.Context.TxHash,
+			}, test.TracerConfig)
+			require.NoError(t, err)
+
+			applyMessage(t, test, tracer)
+			res, err := tracer.GetResult()
+			require.NoError(t, err)
+			require.JSONEq(t, string(test.Result), string(res))
+		})
+	}
+}
+
+// applyMessage executes the input of the test on a state holding the genesis
+// accounts, the same way the EVM keeper does once the ante handler has run.
+func applyMessage(t *testing.T, test *traceTest, tracer tracers.Tracer) {
+	t.Helper()
+	stateDB, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	for addr, acc := range test.Genesis.Alloc {
+		stateDB.SetBalance(addr, acc.Balance)
+		stateDB.SetNonce(addr, acc.Nonce)
+		stateDB.SetCode(addr, acc.Code)
+		for key, value := range acc.Storage {
+			stateDB.SetState(addr, key, value)
+		}
+	}
+	stateDB.Finalise(true)
+
+	var (
+		input       = test.Input
+		chainConfig = params.AllEthashProtocolChanges
+		blockNumber = new(big.Int).SetUint64(uint64(test.Context.Number))
+		value       = new(big.Int)
+		create      = input.To == nil
+	)
+	if input.Value != nil {
+		value = input.Value.ToInt()
+	}
+	evm := vm.NewEVM(
+		vm.BlockContext{
+			CanTransfer: evmoscore.CanTransfer,
+			Transfer:    evmoscore.Transfer,
+			GetHash:     func(uint64) common.Hash { return common.Hash{} },
+			Coinbase:    test.Context.Miner,
+			GasLimit:    uint64(test.Context.GasLimit),
+			BlockNumber: blockNumber,
+			Time:        new(big.Int).SetUint64(uint64(test.Context.Time)),
+			Difficulty:  new(big.Int),
+			BaseFee:     new(big.Int),
+		},
+		vm.TxContext{Origin: input.From, GasPrice: new(big.Int)},
+		stateDB,
+		chainConfig,
+		vm.Config{Debug: true, Tracer: tracer},
+	)
+
+	leftoverGas := uint64(input.Gas)
+	tracer.CaptureTxStart(leftoverGas)
+	intrinsicGas, err := core.IntrinsicGas(input.Input, nil, create, true, true)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, leftoverGas, intrinsicGas)
+	leftoverGas -= intrinsicGas
+
+	stateDB.PrepareAccessList(input.From, input.To, vm.DefaultActivePrecompiles(chainConfig.Rules(blockNumber, false)), nil)
+	sender := vm.AccountRef(input.From)
+	if create {
+		nonce := stateDB.GetNonce(input.From)
+		_, _, leftoverGas, _ = evm.Create(sender, input.Input, leftoverGas, value)
+		stateDB.SetNonce(input.From, nonce+1)
+	} else {
+		_, leftoverGas, _ = evm.Call(sender, *input.To, input.Input, leftoverGas, value)
+	}
+
+	// After EIP-3529: refunds are capped to gasUsed / 5
+	refund := stateDB.GetRefund()
+	if maxRefund := (uint64(input.Gas) - leftoverGas) / params.RefundQuotientEIP3529; refund > maxRefund {
+		refund = maxRefund
+	}
+	tracer.CaptureTxEnd(leftoverGas + refund)
+}
@@ -0,0 +1,243 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/os/x/evm/core/tracers"
+	"github.com/evmos/os/x/evm/core/vm"
+)
+
+func init() {
+	register("vmTraceTracer", newVMTraceTracer)
+}
+
+// vmTrace is the Parity-style trace of the code executed in a call frame.
+type vmTrace struct {
+	Code string       `json:"code"`
+	Ops  []*vmTraceOp `json:"ops"`
+}
+
+type vmTraceOp struct {
+	Cost uint64     `json:"cost"`
+	Ex   *vmTraceEx `json:"ex"`
+	Pc   uint64     `json:"pc"`
+	Sub  *vmTrace   `json:"sub"`
+	Op   string     `json:"op"`
+}
+
+type vmTraceEx struct {
+	Mem   *vmTraceMem   `json:"mem"`
+	Push  []string      `json:"push"`
+	Store *vmTraceStore `json:"store"`
+	Used  uint64        `json:"used"`
+}
+
+type vmTraceMem struct {
+	Data string `json:"data"`
+	Off  uint64 `json:"off"`
+}
+
+type vmTraceStore struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// vmTraceFrame holds the trace of a call frame while it is being executed.
+// The effects of an operation are only known once it has been executed, so
+// the last operation is completed on the next step of the frame or when the
+// frame exits.
+type vmTraceFrame struct {
+	trace   *vmTrace
+	last    *vmTraceOp
+	pushes  int
+	memOff  uint64
+	memSize uint64
+}
+
+// vmTraceTracer reports the executed operations and their effects on the
+// stack, memory and storage in the Parity vmTrace format.
+type vmTraceTracer struct {
+	env       *vm.EVM
+	table     *vm.JumpTable
+	root      *vmTrace
+	frames    []*vmTraceFrame
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newVMTraceTracer returns a native go tracer which reports the executed
+// operations of a tx, and implements vm.EVMLogger.
+func newVMTraceTracer(_ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &vmTraceTracer{}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *vmTraceTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.table = env.Interpreter().Config().JumpTable
+	if t.table == nil {
+		rules := env.ChainConfig().Rules(env.Context.BlockNumber, env.Context.Random != nil)
+		t.table = vm.DefaultJumpTable(rules)
+	}
+
+	t.root = t.newTrace(to, create, input)
+	t.frames = []*vmTraceFrame{{trace: t.root}}
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *vmTraceTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	t.frames[0].complete(nil)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *vmTraceTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if depth < 1 || depth > len(t.frames) {
+		return
+	}
+	frame := t.frames[depth-1]
+	// The gas left after the last operation is only known on the next step,
+	// a call returns the gas not used by its frame.
+	if frame.last != nil {
+		frame.last.Ex.Used = gas
+	}
+	frame.complete(scope)
+
+	used := uint64(0)
+	if gas > cost {
+		used = gas - cost
+	}
+	traceOp := &vmTraceOp{
+		Cost: cost,
+		Pc:   pc,
+		Op:   op.String(),
+		Ex:   &vmTraceEx{Push: []string{}, Used: used},
+	}
+
+	stackData := scope.Stack.Data
+	stackLen := len(stackData)
+	frame.memOff, frame.memSize = 0, 0
+	switch {
+	case op == vm.SSTORE && stackLen >= 2:
+		traceOp.Ex.Store = &vmTraceStore{
+			Key: hexutil.EncodeBig(stackData[stackLen-1].ToBig()),
+			Val: hexutil.EncodeBig(stackData[stackLen-2].ToBig()),
+		}
+	case op == vm.MSTORE && stackLen >= 1:
+		frame.memOff, frame.memSize = stackData[stackLen-1].Uint64(), 32
+	case op == vm.MSTORE8 && stackLen >= 1:
+		frame.memOff, frame.memSize = stackData[stackLen-1].Uint64(), 1
+	case (op == vm.CALLDATACOPY || op == vm.CODECOPY || op == vm.RETURNDATACOPY) && stackLen >= 3:
+		frame.memOff, frame.memSize = stackData[stackLen-1].Uint64(), stackData[stackLen-3].Uint64()
+	case op == vm.EXTCODECOPY && stackLen >= 4:
+		frame.memOff, frame.memSize = stackData[stackLen-2].Uint64(), stackData[stackLen-4].Uint64()
+	case (op == vm.CALL || op == vm.CALLCODE) && stackLen >= 7:
+		frame.memOff, frame.memSize = stackData[stackLen-6].Uint64(), stackData[stackLen-7].Uint64()
+	case (op == vm.DELEGATECALL || op == vm.STATICCALL) && stackLen >= 6:
+		frame.memOff, frame.memSize = stackData[stackLen-5].Uint64(), stackData[stackLen-6].Uint64()
+	}
+
+	_, frame.pushes = t.table.StackEffect(op)
+	frame.last = traceOp
+	frame.trace.Ops = append(frame.trace.Ops, traceOp)
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *vmTraceTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *vmTraceTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+
+	frame := &vmTraceFrame{}
+	// A self destruct does not execute any code, the frame is only kept to
+	// match the exit event.
+	if typ != vm.SELFDESTRUCT {
+		frame.trace = t.newTrace(to, typ == vm.CREATE || typ == vm.CREATE2, input)
+		if parent := t.frames[len(t.frames)-1]; parent.last != nil {
+			parent.last.Sub = frame.trace
+		}
+	}
+	t.frames = append(t.frames, frame)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *vmTraceTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.frames) <= 1 {
+		return
+	}
+	t.frames[len(t.frames)-1].complete(nil)
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+func (*vmTraceTracer) CaptureTxStart(gasLimit uint64) {}
+
+func (*vmTraceTracer) CaptureTxEnd(restGas uint64) {}
+
+// GetResult returns the json-encoded vm trace, and any error arising from
+// the encoding or forceful termination (via `Stop`).
+func (t *vmTraceTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *vmTraceTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// newTrace returns an empty trace for the code executed at the given address.
+// The code of a contract creation is its init code.
+func (t *vmTraceTracer) newTrace(addr common.Address, create bool, input []byte) *vmTrace {
+	code := input
+	if !create {
+		code = t.env.StateDB.GetCode(addr)
+	}
+	return &vmTrace{Code: bytesToHex(code), Ops: []*vmTraceOp{}}
+}
+
+// complete records the effects of the last executed operation of the frame
+// on the stack and memory. The scope is nil when the frame has exited.
+func (f *vmTraceFrame) complete(scope *vm.ScopeContext) {
+	if f.last == nil {
+		return
+	}
+	last := f.last
+	f.last = nil
+	if scope == nil {
+		return
+	}
+
+	stackData := scope.Stack.Data
+	if f.pushes > 0 && f.pushes <= len(stackData) {
+		for _, item := range stackData[len(stackData)-f.pushes:] {
+			last.Ex.Push = append(last.Ex.Push, hexutil.EncodeBig(item.ToBig()))
+		}
+	}
+	if f.memSize > 0 && f.memOff+f.memSize <= uint64(scope.Memory.Len()) { //#nosec G115 -- memory length is never negative
+		last.Ex.Mem = &vmTraceMem{
+			Data: bytesToHex(scope.Memory.GetCopy(int64(f.memOff), int64(f.memSize))), //#nosec G115 -- checked against the memory length
+			Off:  f.memOff,
+		}
+	}
+}
@@ -33,3 +33,26 @@ func TestJumpTableCopy(t *testing.T) {
 	require.Equal(t, uint64(100), deepCopy[SLOAD].constantGas)
 	require.Equal(t, uint64(0), tbl[SLOAD].constantGas)
 }
+
+func TestJumpTableStackEffect(t *testing.T) {
+	tbl := newMergeInstructionSet()
+
+	testCases := []struct {
+		op     OpCode
+		pops   int
+		pushes int
+	}{
+		{ADD, 2, 1},
+		{PUSH1, 0, 1},
+		{DUP3, 3, 4},
+		{SWAP2, 3, 3},
+		{SSTORE, 2, 0},
+		{CALL, 7, 1},
+		{STOP, 0, 0},
+	}
+	for _, tc := range testCases {
+		pops, pushes := tbl.StackEffect(tc.op)
+		require.Equal(t, tc.pops, pops, tc.op.String())
+		require.Equal(t, tc.pushes, pushes, tc.op.String())
+	}
+}
@@ -43,3 +43,13 @@ func maxStack(pop, push int) int {
 func minStack(pops, _ int) int {
 	return pops
 }
+
+// StackEffect returns the number of stack items popped and pushed by the given
+// opcode according to the jump table. Undefined opcodes neither pop nor push.
+func (jt *JumpTable) StackEffect(op OpCode) (pops, pushes int) {
+	operation := jt[op]
+	if operation == nil {
+		return 0, 0
+	}
+	return operation.minStack, int(params.StackLimit) + operation.minStack - operation.maxStack
+}
@@ -4,11 +4,13 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/evmos/os/x/evm/core/logger"
@@ -17,14 +19,18 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/os/x/evm/core/vm"
 
@@ -38,6 +44,12 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	// maxRangeResults is the maximum number of entries returned by the
+	// AccountRange and StorageRangeAt queries.
+	maxRangeResults = 256
+	// maxAccessListIterations is the maximum number of executions of the call
+	// by the CreateAccessList query before the access list converges.
+	maxAccessListIterations = 32
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -234,13 +246,21 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	stateOverrides, blockOverrides, err := parseCallOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	cfg.Overrides = stateOverrides
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getNonceWithOverrides(ctx, args.GetFrom(), stateOverrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -286,6 +306,11 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	stateOverrides, blockOverrides, err := parseCallOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -317,8 +342,11 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	cfg.Overrides = stateOverrides
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getNonceWithOverrides(ctx, args.GetFrom(), stateOverrides)
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
@@ -334,6 +362,10 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		baseDenom := types.GetEVMCoinDenom()
 
 		balance := k.bankWrapper.GetBalance(ctx, sdk.AccAddress(args.From.Bytes()), baseDenom)
+		// use the overridden balance if the state overrides set one for the sender
+		if account, ok := stateOverrides[args.GetFrom()]; ok && account.Balance != nil && *account.Balance != nil {
+			balance.Amount = sdkmath.NewIntFromBigInt((*account.Balance).ToInt())
+		}
 		available := balance.Amount
 		transfer := "0"
 		if args.Value != nil {
@@ -440,6 +472,197 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api.
+// It executes the call repeatedly with an access list tracer, feeding the
+// access list generated by each run into the next one, until the list
+// stabilises. The sender, the recipient (or created contract) and the active
+// precompiles are excluded from the resulting list.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.QueryCreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stateOverrides, blockOverrides, err := parseCallOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	cfg.Overrides = stateOverrides
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.getNonceWithOverrides(ctx, from, stateOverrides)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the recipient is excluded from the access list, use the address of the
+	// created contract in case of a contract creation
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+
+	// precompiles are always warm, so they don't need to be added to the list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	precompiles := slices.Concat(vm.DefaultActivePrecompiles(rules), cfg.Params.GetActiveStaticPrecompilesAddrs())
+
+	var initialList ethtypes.AccessList
+	if args.AccessList != nil {
+		initialList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(initialList, from, to, precompiles)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// the access list of the call may change with the gas left, e.g. when the
+	// touched slots depend on it, so the executions are bounded
+	for i := 0; i < maxAccessListIterations; i++ {
+		if err := c.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.QueryCreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+
+	return nil, status.Errorf(codes.Internal, "access list did not converge after %d executions", maxAccessListIterations)
+}
+
+// SimulateV1 implements eth_simulateV1 rpc api.
+// It executes the calls of a sequence of simulated blocks in order, each one on
+// top of the state left by the previous ones, and returns their results. The
+// state changes are discarded once the simulation is done.
+func (k Keeper) SimulateV1(c context.Context, req *types.QuerySimulateV1Request) (*types.QuerySimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	headers, err := simulatedHeaders(ctx, cfg, opts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	blocks, err := k.simulateBlocks(ctx, cfg, headers, opts, req.GasCap)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySimulateV1Response{Blocks: blocks}, nil
+}
+
+// parseCallOverrides decodes the JSON encoded state and block overrides of a
+// call request. It returns nil values for the overrides that are not set.
+func parseCallOverrides(overridesBz, blockOverridesBz []byte) (types.StateOverride, *types.BlockOverrides, error) {
+	var (
+		stateOverrides types.StateOverride
+		blockOverrides *types.BlockOverrides
+	)
+
+	if len(overridesBz) > 0 {
+		if err := json.Unmarshal(overridesBz, &stateOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid state overrides: %w", err)
+		}
+		if err := stateOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(blockOverridesBz) > 0 {
+		if err := json.Unmarshal(blockOverridesBz, &blockOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid block overrides: %w", err)
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return stateOverrides, blockOverrides, nil
+}
+
+// applyBlockOverrides applies the given block overrides onto the context and
+// the EVM config used to execute a message call. It returns the updated context.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides == nil {
+		return ctx
+	}
+
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) //#nosec G115 -- checked on Validate
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+
+	return ctx
+}
+
+// getNonceWithOverrides returns the nonce of the given address. If the state
+// overrides set a nonce for the address, the overridden one is returned.
+func (k Keeper) getNonceWithOverrides(ctx sdk.Context, addr common.Address, overrides types.StateOverride) uint64 {
+	if account, ok := overrides[addr]; ok && account.Nonce != nil {
+		return uint64(*account.Nonce)
+	}
+	return k.GetNonce(ctx, addr)
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -488,23 +711,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// need to reset gas meter per transaction to be consistent with tx execution
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
-	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
-		if err != nil {
-			continue
-		}
-		txConfig.TxHash = ethTx.Hash()
-		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
-		// reset gas meter for each transaction
-		ctx = evmante.BuildEvmExecutionCtx(ctx).
-			WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
-		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
-		if err != nil {
-			continue
-		}
-		txConfig.LogIndex += uint(len(rsp.Logs))
-	}
+	txConfig = k.replayPredecessors(ctx, cfg, signer, txConfig, req.Predecessors)
 
 	tx := req.Msg.AsTransaction()
 	txConfig.TxHash = tx.Hash()
@@ -534,6 +741,92 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block. The call
+// is never committed. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stateOverrides, blockOverrides, err := parseCallOverrides(req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// compute and use base fee of the height that is being traced
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	cfg.Overrides = stateOverrides
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.getNonceWithOverrides(ctx, args.GetFrom(), stateOverrides)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	// pass false to not commit StateDB
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -580,12 +873,18 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -605,6 +904,241 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// IntermediateRoots executes all the transactions of the queried block and
+// returns a state commitment after each of them. The commitment of a transaction
+// is the hash of the previous commitment and of the EVM state changes performed
+// by the transaction, so that it commits to the block state up to that point.
+func (k Keeper) IntermediateRoots(c context.Context, req *types.QueryIntermediateRootsRequest) (*types.QueryIntermediateRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := blockContext(sdk.UnwrapSDKContext(c), req.BlockNumber, req.BlockTime, req.BlockHash, req.BlockMaxGas)
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// compute and use base fee of the height that is being traced
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	var root common.Hash
+	cfg.CommitHook = func(dirtyHash common.Hash) {
+		root = crypto.Keccak256Hash(root.Bytes(), dirtyHash.Bytes())
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	roots := make([][]byte, 0, len(req.Txs))
+
+	for i, tx := range req.Txs {
+		ethTx := tx.AsTransaction()
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build message of tx %s: %s", ethTx.Hash(), err.Error())
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+		// reset gas meter for each transaction
+		ctx = evmante.BuildEvmExecutionCtx(ctx).
+			WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to apply tx %s: %s", ethTx.Hash(), err.Error())
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+		roots = append(roots, root.Bytes())
+	}
+
+	return &types.QueryIntermediateRootsResponse{
+		Roots: roots,
+	}, nil
+}
+
+// AccountRange returns the state of the accounts at the queried height, starting
+// from the given address. The accounts are sorted by address.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	maxResults := req.MaxResults
+	if maxResults == 0 || maxResults > maxRangeResults {
+		maxResults = maxRangeResults
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryAccountRangeResponse{}
+
+	err := k.iterateAccountsFrom(ctx, req.Start, func(account sdk.AccountI) bool {
+		addrBz := account.GetAddress().Bytes()
+		// skip the accounts that can't be addressed from the EVM
+		if len(addrBz) != common.AddressLength {
+			return false
+		}
+
+		if uint64(len(res.Accounts)) == maxResults {
+			res.Next = addrBz
+			return true
+		}
+
+		addr := common.BytesToAddress(addrBz)
+		codeHash := k.GetCodeHash(ctx, addr)
+		dumpAccount := types.DumpAccount{
+			Address:  addr.Hex(),
+			Balance:  k.GetBalance(ctx, addr).String(),
+			Nonce:    account.GetSequence(),
+			CodeHash: codeHash.Hex(),
+		}
+
+		if !req.NoCode && !types.IsEmptyCodeHash(codeHash.Bytes()) {
+			dumpAccount.Code = k.GetCode(ctx, codeHash)
+		}
+		if !req.NoStorage {
+			dumpAccount.Storage = k.GetAccountStorage(ctx, addr)
+		}
+
+		res.Accounts = append(res.Accounts, dumpAccount)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// iterateAccountsFrom iterates over the accounts sorted by address, starting
+// from the given one. The accounts store of the x/auth keeper is iterated from
+// the start address, the other account keepers are iterated from the first
+// account.
+func (k Keeper) iterateAccountsFrom(ctx sdk.Context, start []byte, cb func(account sdk.AccountI) (stop bool)) error {
+	ak, ok := k.accountKeeper.(authkeeper.AccountKeeper)
+	if !ok {
+		k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+			if bytes.Compare(account.GetAddress(), start) < 0 {
+				return false
+			}
+			return cb(account)
+		})
+		return nil
+	}
+
+	var ranger collections.Ranger[sdk.AccAddress]
+	if len(start) > 0 {
+		ranger = new(collections.Range[sdk.AccAddress]).StartInclusive(start)
+	}
+	return ak.Accounts.Walk(ctx, ranger, func(_ sdk.AccAddress, account sdk.AccountI) (bool, error) {
+		return cb(account), nil
+	})
+}
+
+// StorageRangeAt returns the storage of a contract after replaying the given
+// predecessors of the queried block, starting from the given storage key. The
+// storage entries are sorted by key.
+func (k Keeper) StorageRangeAt(c context.Context, req *types.QueryStorageRangeAtRequest) (*types.QueryStorageRangeAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	maxResults := req.MaxResults
+	if maxResults == 0 || maxResults > maxRangeResults {
+		maxResults = maxRangeResults
+	}
+
+	ctx := blockContext(sdk.UnwrapSDKContext(c), req.BlockNumber, req.BlockTime, req.BlockHash, req.BlockMaxGas)
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// compute and use base fee of the height that is being traced
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	k.replayPredecessors(ctx, cfg, signer, txConfig, req.Predecessors)
+
+	addr := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	iterator := store.Iterator(req.KeyStart, nil)
+	defer iterator.Close()
+
+	res := &types.QueryStorageRangeAtResponse{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := common.BytesToHash(iterator.Key())
+		if uint64(len(res.Storage)) == maxResults {
+			res.NextKey = key.Hex()
+			break
+		}
+
+		res.Storage = append(res.Storage, types.NewState(key, common.BytesToHash(iterator.Value())))
+	}
+
+	return res, nil
+}
+
+// blockContext returns the context used to execute the transactions of the
+// block with the given header fields.
+func blockContext(ctx sdk.Context, blockNumber int64, blockTime time.Time, blockHash string, blockMaxGas int64) sdk.Context {
+	contextHeight := blockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(blockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(blockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	return ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: blockMaxGas},
+	})
+}
+
+// replayPredecessors applies and commits the given transactions of a block to
+// get the state in which the next transaction of the block is executed. Failed
+// transactions are skipped. It returns the tx config for the next transaction.
+func (k *Keeper) replayPredecessors(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	signer ethtypes.Signer,
+	txConfig statedb.TxConfig,
+	predecessors []*types.MsgEthereumTx,
+) statedb.TxConfig {
+	for i, tx := range predecessors {
+		ethTx := tx.AsTransaction()
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+		// reset gas meter for each transaction
+		ctx = evmante.BuildEvmExecutionCtx(ctx).
+			WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+		if err != nil {
+			continue
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+	return txConfig
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -615,6 +1149,24 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -623,10 +1175,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -649,9 +1197,10 @@ func (k *Keeper) traceTx(
 	tracer = logger.NewStructLogger(&logConfig)
 
 	tCtx := &tracers.Context{
-		BlockHash: txConfig.BlockHash,
-		TxIndex:   int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
-		TxHash:    txConfig.TxHash,
+		BlockHash:   txConfig.BlockHash,
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
+		TxHash:      txConfig.TxHash,
 	}
 
 	if traceConfig.Tracer != "" {
@@ -1,16 +1,19 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/os/server/config"
@@ -18,12 +21,15 @@ import (
 	"github.com/evmos/os/testutil/integration/os/factory"
 	testkeyring "github.com/evmos/os/testutil/integration/os/keyring"
 	"github.com/evmos/os/testutil/integration/os/network"
+	utiltx "github.com/evmos/os/testutil/tx"
 	ethlogger "github.com/evmos/os/x/evm/core/logger"
 	"github.com/evmos/os/x/evm/core/vm"
 	"github.com/evmos/os/x/evm/keeper/testdata"
 	"github.com/evmos/os/x/evm/statedb"
 	"github.com/evmos/os/x/evm/types"
 	feemarkettypes "github.com/evmos/os/x/feemarket/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Not valid Ethereum address
@@ -1393,6 +1399,114 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTraceBlockParityTracers() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	recipient := common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+	senderKey := suite.keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, suite.factory)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	msgToTrace, err := executeTransferCall(
+		transferParams{
+			senderKey:     senderKey,
+			contractAddr:  contractAddr,
+			recipientAddr: recipient,
+		},
+		suite.factory,
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	traceReq := getDefaultTraceBlockRequest(suite.network)
+	traceReq.BlockNumber = suite.network.GetContext().BlockHeight()
+	traceReq.Txs = []*types.MsgEthereumTx{msgToTrace}
+	// the tracer config is forwarded to the tracer of every tx
+	traceReq.TraceConfig = &types.TraceConfig{
+		Tracer:           "muxTracer",
+		TracerJsonConfig: `{"flatCallTracer":{},"stateDiffTracer":{},"vmTraceTracer":{}}`,
+	}
+
+	res, err := suite.network.GetEvmClient().TraceBlock(suite.network.GetContext(), &traceReq)
+	suite.Require().NoError(err)
+
+	var results []struct {
+		Result struct {
+			FlatCallTracer []struct {
+				Action struct {
+					CallType string `json:"callType"`
+					From     string `json:"from"`
+					To       string `json:"to"`
+					Value    string `json:"value"`
+				} `json:"action"`
+				BlockNumber         int64  `json:"blockNumber"`
+				TraceAddress        []int  `json:"traceAddress"`
+				TransactionHash     string `json:"transactionHash"`
+				TransactionPosition int    `json:"transactionPosition"`
+				Type                string `json:"type"`
+			} `json:"flatCallTracer"`
+			StateDiffTracer map[common.Address]struct {
+				Balance interface{}            `json:"balance"`
+				Storage map[string]interface{} `json:"storage"`
+			} `json:"stateDiffTracer"`
+			VMTraceTracer struct {
+				Code string `json:"code"`
+				Ops  []struct {
+					Op string `json:"op"`
+					Ex struct {
+						Push  []string         `json:"push"`
+						Store *json.RawMessage `json:"store"`
+					} `json:"ex"`
+				} `json:"ops"`
+			} `json:"vmTraceTracer"`
+		} `json:"result"`
+		Error string `json:"error"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 1)
+	suite.Require().Empty(results[0].Error)
+	result := results[0].Result
+
+	// the ERC-20 transfer does not call any other contract
+	suite.Require().Len(result.FlatCallTracer, 1)
+	frame := result.FlatCallTracer[0]
+	suite.Require().Equal("call", frame.Type)
+	suite.Require().Equal("call", frame.Action.CallType)
+	suite.Require().Equal(strings.ToLower(senderKey.Addr.Hex()), frame.Action.From)
+	suite.Require().Equal(strings.ToLower(contractAddr.Hex()), frame.Action.To)
+	suite.Require().Equal("0x0", frame.Action.Value)
+	suite.Require().Equal(traceReq.BlockNumber, frame.BlockNumber)
+	suite.Require().Equal(msgToTrace.AsTransaction().Hash().Hex(), frame.TransactionHash)
+	suite.Require().Equal(0, frame.TransactionPosition)
+	suite.Require().Empty(frame.TraceAddress)
+
+	// the token balances of the sender and of the recipient are the two storage
+	// slots updated in the contract, whose native balance is unchanged. The gas
+	// is paid outside of the EVM execution, so the sender account is not changed.
+	contractDiff, ok := result.StateDiffTracer[contractAddr]
+	suite.Require().True(ok)
+	suite.Require().Equal("=", contractDiff.Balance)
+	suite.Require().Len(contractDiff.Storage, 2)
+	_, ok = result.StateDiffTracer[senderKey.Addr]
+	suite.Require().False(ok, "the sender state is not changed by the EVM execution")
+
+	ops := result.VMTraceTracer.Ops
+	suite.Require().NotEmpty(ops)
+	suite.Require().Equal("PUSH1", ops[0].Op)
+	suite.Require().Equal([]string{"0x80"}, ops[0].Ex.Push)
+	stores := 0
+	for _, op := range ops {
+		if op.Op == "SSTORE" {
+			suite.Require().NotNil(op.Ex.Store)
+			stores++
+		}
+	}
+	suite.Require().Equal(2, stores)
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	suite.enableFeemarket = true
 	defer func() { suite.enableFeemarket = false }()
@@ -1663,6 +1777,633 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallOverrides() {
+	sender := suite.keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// runtime code returning the value stored on slot 0
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	// runtime code returning the balance of the caller
+	balanceCode := hexutil.Bytes(common.FromHex("0x333160005260206000f3"))
+	// runtime code returning the block number
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+
+	slotValue := common.BigToHash(big.NewInt(42))
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+
+	testCases := []struct {
+		name           string
+		stateOverrides types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expPass        bool
+		expRet         []byte
+	}{
+		{
+			"pass - code and state diff overrides",
+			types.StateOverride{
+				contract: types.OverrideAccount{
+					Code:      &sloadCode,
+					StateDiff: &map[common.Hash]common.Hash{{}: slotValue},
+				},
+			},
+			nil,
+			true,
+			slotValue.Bytes(),
+		},
+		{
+			"pass - balance override",
+			types.StateOverride{
+				contract: types.OverrideAccount{Code: &balanceCode},
+				sender:   types.OverrideAccount{Balance: &balance},
+			},
+			nil,
+			true,
+			common.BigToHash(big.NewInt(1e18)).Bytes(),
+		},
+		{
+			"pass - block number override",
+			types.StateOverride{
+				contract: types.OverrideAccount{Code: &numberCode},
+			},
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))},
+			true,
+			common.BigToHash(big.NewInt(1000)).Bytes(),
+		},
+		{
+			"fail - both state and state diff overrides",
+			types.StateOverride{
+				contract: types.OverrideAccount{
+					State:     &map[common.Hash]common.Hash{{}: slotValue},
+					StateDiff: &map[common.Hash]common.Hash{{}: slotValue},
+				},
+			},
+			nil,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(tc.stateOverrides)
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.network.GetEvmClient().EthCall(suite.network.GetContext(), req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+				suite.Require().Equal(tc.expRet, res.Ret)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			// overrides are not persisted
+			code := suite.network.App.EVMKeeper.GetCode(suite.network.GetContext(), common.BytesToHash(crypto.Keccak256(sloadCode)))
+			suite.Require().Empty(code)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	sender := suite.keyring.GetAddr(0)
+	recipient := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	reader := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// runtime code returning the balance of the recipient
+	balanceCode := hexutil.Bytes(common.FromHex("0x73" + recipient.Hex()[2:] + "3160005260206000f3"))
+	value := (*hexutil.Big)(big.NewInt(1000))
+
+	testCases := []struct {
+		name      string
+		malleate  func(height int64) types.SimOpts
+		gasCap    uint64
+		expPass   bool
+		expBlocks int
+	}{
+		{
+			"pass - state is threaded through the blocks",
+			func(height int64) types.SimOpts {
+				return types.SimOpts{
+					TraceTransfers: true,
+					BlockStateCalls: []types.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}}},
+						{
+							BlockOverrides: &types.SimBlockOverrides{Number: (*hexutil.Big)(big.NewInt(height + 3))},
+							StateOverrides: types.StateOverride{reader: types.OverrideAccount{Code: &balanceCode}},
+							Calls:          []types.TransactionArgs{{From: &sender, To: &reader}},
+						},
+					},
+				}
+			},
+			config.DefaultGasCap,
+			true,
+			3,
+		},
+		{
+			"fail - block numbers out of order",
+			func(height int64) types.SimOpts {
+				return types.SimOpts{
+					BlockStateCalls: []types.SimBlock{
+						{BlockOverrides: &types.SimBlockOverrides{Number: (*hexutil.Big)(big.NewInt(height + 2))}},
+						{BlockOverrides: &types.SimBlockOverrides{Number: (*hexutil.Big)(big.NewInt(height + 1))}},
+					},
+				}
+			},
+			config.DefaultGasCap,
+			false,
+			0,
+		},
+		{
+			"fail - nonce too high with validation",
+			func(int64) types.SimOpts {
+				nonce := hexutil.Uint64(1000)
+				return types.SimOpts{
+					Validation: true,
+					BlockStateCalls: []types.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: &nonce}}},
+					},
+				}
+			},
+			config.DefaultGasCap,
+			false,
+			0,
+		},
+		{
+			"fail - gas budget spent by the calls of the previous blocks",
+			func(int64) types.SimOpts {
+				return types.SimOpts{
+					BlockStateCalls: []types.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}}},
+						{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}}},
+					},
+				}
+			},
+			ethparams.TxGas,
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.network.GetContext()
+			opts, err := json.Marshal(tc.malleate(ctx.BlockHeight()))
+			suite.Require().NoError(err)
+
+			req := &types.QuerySimulateV1Request{Opts: opts, GasCap: tc.gasCap}
+			res, err := suite.network.GetEvmClient().SimulateV1(ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Blocks, tc.expBlocks)
+
+			// the value transfer is traced
+			transfer := res.Blocks[0].Results[0]
+			suite.Require().Empty(transfer.VmError)
+			suite.Require().Len(transfer.Logs, 1)
+			suite.Require().Equal(types.TransferLogAddress.Hex(), transfer.Logs[0].Address)
+
+			// the gap is filled with an empty block
+			suite.Require().Empty(res.Blocks[1].Txs)
+			suite.Require().Equal(ctx.BlockHeight()+2, res.Blocks[1].Number)
+
+			// the transfer of the first block is visible to the last one
+			read := res.Blocks[2].Results[0]
+			suite.Require().Empty(read.VmError)
+			suite.Require().Equal(common.BigToHash(value.ToInt()).Bytes(), read.Ret)
+
+			// the simulation is not persisted
+			balance := suite.network.App.EVMKeeper.GetBalance(suite.network.GetContext(), recipient)
+			suite.Require().Zero(balance.Sign())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	sender := suite.keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	precompile := common.BytesToAddress([]byte{1})
+
+	// runtime code loading storage slot 0
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005450"))
+	// runtime code loading the storage slot of the gas left, which changes
+	// with the access list
+	sloadGasCode := hexutil.Bytes(common.FromHex("0x5a5450"))
+	// runtime code querying the balance of the given address
+	balanceCode := func(addr common.Address) *hexutil.Bytes {
+		code := hexutil.Bytes(append(append([]byte{byte(vm.PUSH20)}, addr.Bytes()...), byte(vm.BALANCE), byte(vm.POP)))
+		return &code
+	}
+
+	testCases := []struct {
+		name          string
+		args          types.TransactionArgs
+		overrides     types.StateOverride
+		expPass       bool
+		expAccessList ethtypes.AccessList
+	}{
+		{
+			"pass - transfer has an empty access list",
+			types.TransactionArgs{From: &sender, To: &other},
+			nil,
+			true,
+			ethtypes.AccessList{},
+		},
+		{
+			"pass - storage slots of the recipient are included",
+			types.TransactionArgs{From: &sender, To: &contract},
+			types.StateOverride{
+				contract: types.OverrideAccount{Code: &sloadCode},
+			},
+			true,
+			ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{{}}}},
+		},
+		{
+			"pass - touched account is included",
+			types.TransactionArgs{From: &sender, To: &contract},
+			types.StateOverride{
+				contract: types.OverrideAccount{Code: balanceCode(other)},
+			},
+			true,
+			ethtypes.AccessList{{Address: other, StorageKeys: []common.Hash{}}},
+		},
+		{
+			"pass - precompiles are excluded",
+			types.TransactionArgs{From: &sender, To: &contract},
+			types.StateOverride{
+				contract: types.OverrideAccount{Code: balanceCode(precompile)},
+			},
+			true,
+			ethtypes.AccessList{},
+		},
+		{
+			"fail - access list does not converge",
+			types.TransactionArgs{From: &sender, To: &contract},
+			types.StateOverride{
+				contract: types.OverrideAccount{Code: &sloadGasCode},
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - both state and state diff overrides",
+			types.TransactionArgs{From: &sender, To: &contract},
+			types.StateOverride{
+				contract: types.OverrideAccount{
+					State:     &map[common.Hash]common.Hash{},
+					StateDiff: &map[common.Hash]common.Hash{},
+				},
+			},
+			false,
+			nil,
+		},
+	}
+
+	suite.Run("fail - canceled context", func() {
+		args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &other})
+		suite.Require().NoError(err)
+
+		canceledCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ctx := suite.network.GetContext().WithContext(canceledCtx)
+
+		_, err = suite.network.App.EVMKeeper.CreateAccessList(ctx, &types.EthCallRequest{
+			Args:    args,
+			GasCap:  config.DefaultGasCap,
+			ChainId: suite.network.GetEIP155ChainID().Int64(),
+		})
+		suite.Require().Equal(codes.Canceled, status.Code(err))
+	})
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.network.GetContext()
+			args, err := json.Marshal(&tc.args)
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(tc.overrides)
+			suite.Require().NoError(err)
+
+			req := &types.EthCallRequest{
+				Args:      args,
+				GasCap:    config.DefaultGasCap,
+				Overrides: overrides,
+				ChainId:   suite.network.GetEIP155ChainID().Int64(),
+			}
+
+			res, err := suite.network.GetEvmClient().CreateAccessList(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+				suite.Require().NotZero(res.GasUsed)
+				suite.Require().ElementsMatch(tc.expAccessList, *res.AccessList.ToEthAccessList())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	sender := suite.keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// runtime code returning the value stored on slot 0
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	slotValue := common.BigToHash(big.NewInt(42))
+
+	testCases := []struct {
+		name          string
+		args          types.TransactionArgs
+		traceConfig   *types.TraceConfig
+		overrides     types.StateOverride
+		expPass       bool
+		expectedTrace string
+	}{
+		{
+			"pass - default tracer with state overrides",
+			types.TransactionArgs{From: &sender, To: &contract},
+			&types.TraceConfig{},
+			types.StateOverride{
+				contract: types.OverrideAccount{
+					Code:      &sloadCode,
+					StateDiff: &map[common.Hash]common.Hash{{}: slotValue},
+				},
+			},
+			true,
+			"\"failed\":false,\"returnValue\":\"" + common.Bytes2Hex(slotValue.Bytes()) + "\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
+		},
+		{
+			"pass - call tracer",
+			types.TransactionArgs{From: &sender, To: &contract},
+			&types.TraceConfig{Tracer: "callTracer"},
+			types.StateOverride{
+				contract: types.OverrideAccount{Code: &sloadCode},
+			},
+			true,
+			"{\"type\":\"CALL\",\"from\":\"" + strings.ToLower(sender.Hex()) + "\",\"to\":\"" + strings.ToLower(contract.Hex()) + "\"",
+		},
+		{
+			"fail - negative limit",
+			types.TransactionArgs{From: &sender, To: &contract},
+			&types.TraceConfig{Limit: -1},
+			nil,
+			false,
+			"",
+		},
+		{
+			"fail - invalid tracer",
+			types.TransactionArgs{From: &sender, To: &contract},
+			&types.TraceConfig{Tracer: "invalid_tracer"},
+			nil,
+			false,
+			"",
+		},
+		{
+			"fail - both state and state diff overrides",
+			types.TransactionArgs{From: &sender, To: &contract},
+			&types.TraceConfig{},
+			types.StateOverride{
+				contract: types.OverrideAccount{
+					State:     &map[common.Hash]common.Hash{{}: slotValue},
+					StateDiff: &map[common.Hash]common.Hash{{}: slotValue},
+				},
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.network.GetContext()
+			args, err := json.Marshal(&tc.args)
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(tc.overrides)
+			suite.Require().NoError(err)
+
+			req := &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: tc.traceConfig,
+				Overrides:   overrides,
+				BlockNumber: ctx.BlockHeight(),
+				BlockTime:   ctx.BlockTime(),
+				ChainId:     suite.network.GetEIP155ChainID().Int64(),
+				BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+			}
+
+			res, err := suite.network.GetEvmClient().TraceCall(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Contains(string(res.Data), tc.expectedTrace)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIntermediateRoots() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	senderKey := suite.keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, suite.factory)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	var txs []*types.MsgEthereumTx
+	for i := 0; i < 2; i++ {
+		txMsg, err := executeTransferCall(
+			transferParams{
+				senderKey:     senderKey,
+				contractAddr:  contractAddr,
+				recipientAddr: utiltx.GenerateAddress(),
+			},
+			suite.factory,
+		)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.network.NextBlock())
+		txs = append(txs, txMsg)
+	}
+
+	ctx := suite.network.GetContext()
+	req := &types.QueryIntermediateRootsRequest{
+		Txs:         txs,
+		BlockNumber: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+		ChainId:     suite.network.GetEIP155ChainID().Int64(),
+		BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+	}
+
+	res, err := suite.network.GetEvmClient().IntermediateRoots(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Roots, 2)
+	suite.Require().NotEqual(common.Hash{}.Bytes(), res.Roots[0])
+	suite.Require().NotEqual(res.Roots[0], res.Roots[1])
+
+	// the commitments are deterministic
+	res2, err := suite.network.GetEvmClient().IntermediateRoots(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Roots, res2.Roots)
+
+	// no transactions
+	req.Txs = nil
+	res, err = suite.network.GetEvmClient().IntermediateRoots(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Roots)
+}
+
+func (suite *KeeperTestSuite) TestAccountRange() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	senderKey := suite.keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, suite.factory)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	ctx := suite.network.GetContext()
+	evmClient := suite.network.GetEvmClient()
+
+	// first page
+	res, err := evmClient.AccountRange(ctx, &types.QueryAccountRangeRequest{MaxResults: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().NotEmpty(res.Next)
+
+	// next page starts from the returned address
+	res2, err := evmClient.AccountRange(ctx, &types.QueryAccountRangeRequest{Start: res.Next, MaxResults: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res2.Accounts, 1)
+	suite.Require().Equal(common.BytesToAddress(res.Next).Hex(), res2.Accounts[0].Address)
+
+	// paging through all the accounts returns the same accounts as a single page
+	all, err := evmClient.AccountRange(ctx, &types.QueryAccountRangeRequest{NoCode: true, NoStorage: true})
+	suite.Require().NoError(err)
+	suite.Require().Empty(all.Next)
+
+	var (
+		paged []types.DumpAccount
+		next  []byte
+	)
+	for {
+		res, err = evmClient.AccountRange(ctx, &types.QueryAccountRangeRequest{Start: next, MaxResults: 2, NoCode: true, NoStorage: true})
+		suite.Require().NoError(err)
+		paged = append(paged, res.Accounts...)
+		if len(res.Next) == 0 {
+			break
+		}
+		next = res.Next
+	}
+	suite.Require().Equal(all.Accounts, paged)
+
+	// contract code and storage
+	res, err = evmClient.AccountRange(ctx, &types.QueryAccountRangeRequest{Start: contractAddr.Bytes(), MaxResults: 1})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Equal(contractAddr.Hex(), res.Accounts[0].Address)
+	suite.Require().NotEmpty(res.Accounts[0].Code)
+	suite.Require().NotEmpty(res.Accounts[0].Storage)
+
+	res, err = evmClient.AccountRange(ctx, &types.QueryAccountRangeRequest{
+		Start:      contractAddr.Bytes(),
+		MaxResults: 1,
+		NoCode:     true,
+		NoStorage:  true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Empty(res.Accounts[0].Code)
+	suite.Require().Empty(res.Accounts[0].Storage)
+}
+
+func (suite *KeeperTestSuite) TestStorageRangeAt() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	senderKey := suite.keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, suite.factory)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	ctx := suite.network.GetContext()
+	evmClient := suite.network.GetEvmClient()
+	storage := suite.network.App.EVMKeeper.GetAccountStorage(ctx, contractAddr)
+	suite.Require().True(len(storage) > 1)
+
+	getRequest := func() *types.QueryStorageRangeAtRequest {
+		return &types.QueryStorageRangeAtRequest{
+			Address:     contractAddr.Hex(),
+			BlockNumber: ctx.BlockHeight(),
+			BlockTime:   ctx.BlockTime(),
+			ChainId:     suite.network.GetEIP155ChainID().Int64(),
+			BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+		}
+	}
+
+	// full storage
+	res, err := evmClient.StorageRangeAt(ctx, getRequest())
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.State(storage), res.Storage)
+	suite.Require().Empty(res.NextKey)
+
+	// paginated storage
+	req := getRequest()
+	req.MaxResults = 1
+	res, err = evmClient.StorageRangeAt(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(storage[:1], types.Storage(res.Storage))
+	suite.Require().Equal(storage[1].Key, res.NextKey)
+
+	req.KeyStart = common.HexToHash(res.NextKey).Bytes()
+	res, err = evmClient.StorageRangeAt(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(storage[1:2], types.Storage(res.Storage))
+
+	// the predecessors are replayed before reading the storage
+	erc20Contract, err := testdata.LoadERC20Contract()
+	suite.Require().NoError(err)
+	transferArgs, err := suite.factory.GenerateContractCallArgs(
+		types.EvmTxArgs{To: &contractAddr},
+		factory.CallArgs{
+			ContractABI: erc20Contract.ABI,
+			MethodName:  "transfer",
+			Args:        []interface{}{utiltx.GenerateAddress(), big.NewInt(1000)},
+		},
+	)
+	suite.Require().NoError(err)
+	signedTx, err := suite.factory.GenerateSignedEthTx(senderKey.Priv, transferArgs)
+	suite.Require().NoError(err)
+	txMsg, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+	suite.Require().True(ok)
+
+	req = getRequest()
+	req.Predecessors = []*types.MsgEthereumTx{txMsg}
+	res, err = evmClient.StorageRangeAt(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Storage, len(storage)+1)
+
+	// invalid address
+	req = getRequest()
+	req.Address = "invalid"
+	_, err = evmClient.StorageRangeAt(ctx, req)
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	suite.SetupTest()
 	k := suite.network.App.EVMKeeper
@@ -1719,6 +2460,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.network.GetContext(), nil)
 			},
 		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(suite.network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -1731,6 +2478,30 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.network.GetContext(), nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.network.GetContext(), nil)
+			},
+		},
+		{
+			"IntermediateRoots method",
+			func() (interface{}, error) {
+				return k.IntermediateRoots(suite.network.GetContext(), nil)
+			},
+		},
+		{
+			"AccountRange method",
+			func() (interface{}, error) {
+				return k.AccountRange(suite.network.GetContext(), nil)
+			},
+		},
+		{
+			"StorageRangeAt method",
+			func() (interface{}, error) {
+				return k.StorageRangeAt(suite.network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
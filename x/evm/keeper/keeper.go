@@ -47,6 +47,9 @@ type Keeper struct {
 	// bankWrapper is used to convert the Cosmos SDK coin used in the EVM to the
 	// proper decimal representation.
 	bankWrapper types.BankWrapper
+	// fractionalBalances is true when the bank wrapper keeps track of the
+	// balances of the evm coin with 18 decimals precision.
+	fractionalBalances bool
 
 	// access historical headers for EVM state transition execution
 	stakingKeeper types.StakingKeeper
@@ -109,6 +112,22 @@ func NewKeeper(
 	}
 }
 
+// WithPreciseBank sets the bank wrapper used by the EVM to a keeper that tracks
+// the balances of the evm coin with 18 decimals precision, like the
+// x/precisebank keeper. Amounts handled by the EVM are then no longer
+// truncated to the decimals of the x/bank representation.
+func (k *Keeper) WithPreciseBank(bankWrapper types.BankWrapper) *Keeper {
+	k.bankWrapper = bankWrapper
+	k.fractionalBalances = true
+	return k
+}
+
+// HasFractionalBalances returns true if the balances of the evm coin are
+// tracked with 18 decimals precision.
+func (k Keeper) HasFractionalBalances() bool {
+	return k.fractionalBalances
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
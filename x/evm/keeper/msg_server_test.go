@@ -9,6 +9,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/evmos/os/testutil/integration/os/utils"
+	utiltx "github.com/evmos/os/testutil/tx"
 	"github.com/evmos/os/x/evm/types"
 )
 
@@ -80,6 +81,63 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 	suite.enableFeemarket = false
 }
 
+func (suite *KeeperTestSuite) TestEthereumTxMultipleMsgs() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	recipient := utiltx.GenerateAddress()
+	generateMsg := func(keyIdx int, nonce uint64) *types.MsgEthereumTx {
+		msg, err := suite.factory.GenerateSignedMsgEthereumTx(
+			suite.keyring.GetPrivKey(keyIdx),
+			types.EvmTxArgs{
+				Nonce:    nonce,
+				To:       &recipient,
+				Amount:   big.NewInt(1e18),
+				GasLimit: 21000,
+			},
+		)
+		suite.Require().NoError(err)
+		return &msg
+	}
+
+	msgs := []*types.MsgEthereumTx{generateMsg(0, 0), generateMsg(1, 0), generateMsg(0, 1)}
+	tx, err := types.BuildTxWithMsgs(
+		suite.network.GetEncodingConfig().TxConfig.NewTxBuilder(),
+		suite.network.GetBaseDenom(),
+		msgs...,
+	)
+	suite.Require().NoError(err)
+
+	txBytes, err := suite.network.GetEncodingConfig().TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	res, err := suite.network.BroadcastTxSync(txBytes)
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), "tx failed: %s", res.Log)
+	suite.Require().Equal(int64(3*21000), res.GasUsed)
+
+	var txData sdktypes.TxMsgData
+	suite.Require().NoError(suite.network.App.AppCodec().Unmarshal(res.Data, &txData))
+	suite.Require().Len(txData.MsgResponses, len(msgs))
+
+	// each message is indexed with its own position in the block
+	var txIndexes []string
+	for _, event := range res.Events {
+		if event.Type != types.EventTypeEthereumTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyTxIndex {
+				txIndexes = append(txIndexes, attr.Value)
+			}
+		}
+	}
+	suite.Require().Contains(txIndexes, "0")
+	suite.Require().Contains(txIndexes, "1")
+	suite.Require().Contains(txIndexes, "2")
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	suite.SetupTest()
 	testCases := []struct {
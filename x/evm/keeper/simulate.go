@@ -0,0 +1,374 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmostypes "github.com/evmos/os/types"
+	"github.com/evmos/os/x/evm/core/vm"
+	"github.com/evmos/os/x/evm/statedb"
+	"github.com/evmos/os/x/evm/types"
+)
+
+// simulatedHeader holds the header fields of a simulated block and its calls.
+// The blocks filling the gaps between the requested block numbers have no
+// calls.
+type simulatedHeader struct {
+	number   int64
+	time     uint64
+	gasLimit uint64
+	coinbase common.Address
+	baseFee  *big.Int
+	block    *types.SimBlock
+}
+
+// simulatedHeaders returns the headers of the blocks to simulate on top of the
+// current block. The block numbers and times must be increasing, the fields
+// that are not overridden are derived from the previous block. The base fee
+// defaults to the current one if the calls are validated, and to zero
+// otherwise so that calls without gas price can be executed.
+func simulatedHeaders(ctx sdk.Context, cfg *statedb.EVMConfig, opts types.SimOpts) ([]simulatedHeader, error) {
+	var (
+		prevNumber = ctx.BlockHeight()
+		prevTime   = uint64(ctx.BlockTime().Unix()) //#nosec G115 -- block times are never negative
+		gasLimit   = evmostypes.BlockGasLimit(ctx)
+		headers    = make([]simulatedHeader, 0, len(opts.BlockStateCalls))
+	)
+
+	baseFee := new(big.Int)
+	if opts.Validation && cfg.BaseFee != nil {
+		baseFee = cfg.BaseFee
+	}
+
+	newHeader := func(number int64, time uint64) simulatedHeader {
+		return simulatedHeader{
+			number:   number,
+			time:     time,
+			gasLimit: gasLimit,
+			coinbase: cfg.CoinBase,
+			baseFee:  baseFee,
+		}
+	}
+
+	for i := range opts.BlockStateCalls {
+		block := &opts.BlockStateCalls[i]
+		overrides := block.BlockOverrides
+		if overrides == nil {
+			overrides = &types.SimBlockOverrides{}
+		}
+
+		number := prevNumber + 1
+		if overrides.Number != nil {
+			number = overrides.Number.ToInt().Int64()
+			if number <= prevNumber {
+				return nil, fmt.Errorf("block numbers must be in order: %d <= %d", number, prevNumber)
+			}
+		}
+
+		// fill the gaps between the requested block numbers with empty blocks
+		for prevNumber+1 < number {
+			if len(headers) >= types.MaxSimulateBlocks {
+				return nil, fmt.Errorf("too many blocks: more than %d", types.MaxSimulateBlocks)
+			}
+			prevNumber++
+			prevTime += types.SimulateTimestampIncrement
+			headers = append(headers, newHeader(prevNumber, prevTime))
+		}
+
+		blockTime := prevTime + types.SimulateTimestampIncrement
+		if overrides.Time != nil {
+			blockTime = uint64(*overrides.Time)
+			if blockTime <= prevTime {
+				return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", blockTime, prevTime)
+			}
+		}
+
+		if len(headers) >= types.MaxSimulateBlocks {
+			return nil, fmt.Errorf("too many blocks: more than %d", types.MaxSimulateBlocks)
+		}
+
+		header := newHeader(number, blockTime)
+		header.block = block
+		if overrides.GasLimit != nil {
+			header.gasLimit = uint64(*overrides.GasLimit)
+		}
+		if overrides.FeeRecipient != nil {
+			header.coinbase = *overrides.FeeRecipient
+		}
+		if overrides.BaseFeePerGas != nil {
+			header.baseFee = overrides.BaseFeePerGas.ToInt()
+		}
+		headers = append(headers, header)
+
+		prevNumber, prevTime = number, blockTime
+	}
+
+	return headers, nil
+}
+
+// simulateGasBudget is the gas left to the calls of a simulation. The gas cap
+// is shared by all the calls of all the simulated blocks, so that a single
+// request cannot execute more gas than the cap. A zero cap disables the budget.
+type simulateGasBudget struct {
+	gasCap    uint64
+	remaining uint64
+}
+
+// newSimulateGasBudget returns the budget of a simulation with the given cap.
+func newSimulateGasBudget(gasCap uint64) *simulateGasBudget {
+	return &simulateGasBudget{gasCap: gasCap, remaining: gasCap}
+}
+
+// callGasCap returns the maximum gas of the next call, or 0 if it is not
+// capped. It fails if the budget is spent.
+func (b *simulateGasBudget) callGasCap() (uint64, error) {
+	if b.gasCap == 0 {
+		return 0, nil
+	}
+	if b.remaining == 0 {
+		return 0, fmt.Errorf("gas budget exhausted: gas cap %d", b.gasCap)
+	}
+	return b.remaining, nil
+}
+
+// consume subtracts the gas used by a call from the budget.
+func (b *simulateGasBudget) consume(gasUsed uint64) {
+	if gasUsed > b.remaining {
+		b.remaining = 0
+		return
+	}
+	b.remaining -= gasUsed
+}
+
+// simulateBlocks executes the calls of the given simulated blocks in order,
+// threading a single StateDB and gas budget through all of them. The changes
+// are written to a cache of the context which is discarded once the simulation
+// is done.
+func (k Keeper) simulateBlocks(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	headers []simulatedHeader,
+	opts types.SimOpts,
+	gasCap uint64,
+) ([]types.SimulatedBlock, error) {
+	ctx, _ = ctx.CacheContext()
+	stateDB := statedb.New(ctx, &k, statedb.NewEmptyTxConfig(common.Hash{}))
+	budget := newSimulateGasBudget(gasCap)
+
+	blocks := make([]types.SimulatedBlock, 0, len(headers))
+	for _, header := range headers {
+		block, err := k.simulateBlock(ctx, cfg, stateDB, header, opts, budget)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", header.number, err)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// simulateBlock executes the calls of a simulated block on top of the state
+// of the given StateDB, after applying the state overrides of the block.
+//
+// The sender nonce of every call is increased, as done by the ante handler for
+// the transactions. If the calls are validated, their nonce and the balance of
+// their sender are checked, and the fees are charged to the sender.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	stateDB *statedb.StateDB,
+	header simulatedHeader,
+	opts types.SimOpts,
+	budget *simulateGasBudget,
+) (types.SimulatedBlock, error) {
+	res := types.SimulatedBlock{
+		Number:       header.number,
+		Time:         header.time,
+		GasLimit:     header.gasLimit,
+		FeeRecipient: header.coinbase.Hex(),
+		BaseFee:      sdkmath.NewIntFromBigInt(header.baseFee),
+		Txs:          []*types.MsgEthereumTx{},
+		Results:      []types.MsgEthereumTxResponse{},
+	}
+	if header.block == nil {
+		return res, nil
+	}
+
+	blockCfg := *cfg
+	blockCfg.CoinBase = header.coinbase
+	blockCfg.BaseFee = header.baseFee
+	blockCfg.Overrides = nil
+
+	ctx = ctx.
+		WithBlockHeight(header.number).
+		WithBlockTime(time.Unix(int64(header.time), 0).UTC()). //#nosec G115 -- checked on Validate
+		WithBlockGasMeter(storetypes.NewGasMeter(header.gasLimit))
+
+	if header.block.StateOverrides != nil {
+		if err := stateDB.ApplyStateOverrides(header.block.StateOverrides); err != nil {
+			return res, err
+		}
+	}
+
+	var logIndex uint
+	for i := range header.block.Calls {
+		args := header.block.Calls[i]
+		if err := setSimulatedCallDefaults(&args, stateDB, cfg.ChainConfig.ChainID, header.gasLimit-res.GasUsed, opts.Validation); err != nil {
+			return res, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		tx := args.ToTransaction()
+		txConfig := statedb.NewTxConfig(common.Hash{}, common.HexToHash(tx.Hash), uint(i), logIndex)
+		if err := stateDB.PrepareTx(txConfig); err != nil {
+			return res, err
+		}
+
+		gasCap, err := budget.callGasCap()
+		if err != nil {
+			return res, fmt.Errorf("call %d: %w", i, err)
+		}
+		msg, err := args.ToMessage(gasCap, header.baseFee)
+		if err != nil {
+			return res, fmt.Errorf("call %d: %w", i, err)
+		}
+		if opts.Validation {
+			if err := validateSimulatedCall(stateDB, msg, header.baseFee); err != nil {
+				return res, fmt.Errorf("call %d: %w", i, err)
+			}
+		}
+
+		var tracer vm.EVMLogger
+		if opts.TraceTransfers {
+			tracer = newTransferTracer(stateDB)
+		}
+
+		callRes, err := k.applyMessageWithStateDB(ctx, msg, tracer, false, &blockCfg, txConfig, stateDB)
+		if err != nil {
+			return res, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		// the nonce of contract creations is already increased by the EVM
+		if msg.To() != nil {
+			stateDB.SetNonce(msg.From(), msg.Nonce()+1)
+		}
+		if opts.Validation {
+			fee := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(callRes.GasUsed))
+			stateDB.SubBalance(msg.From(), fee)
+		}
+
+		budget.consume(callRes.GasUsed)
+		res.GasUsed += callRes.GasUsed
+		logIndex += uint(len(callRes.Logs))
+		res.Txs = append(res.Txs, tx)
+		res.Results = append(res.Results, *callRes)
+	}
+
+	// write the changes of the last call for the next blocks
+	return res, stateDB.PrepareTx(statedb.NewEmptyTxConfig(common.Hash{}))
+}
+
+// setSimulatedCallDefaults fills in the nonce, gas limit and chain id of a
+// simulated call. The nonce defaults to the one of the sender and must match it
+// if the calls are validated. The gas limit defaults to the gas left in the
+// block and cannot exceed it.
+func setSimulatedCallDefaults(
+	args *types.TransactionArgs,
+	stateDB *statedb.StateDB,
+	chainID *big.Int,
+	gasLeft uint64,
+	validation bool,
+) error {
+	nonce := stateDB.GetNonce(args.GetFrom())
+	switch {
+	case args.Nonce == nil:
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	case validation && uint64(*args.Nonce) < nonce:
+		return fmt.Errorf("nonce too low: address %s, tx: %d state: %d", args.GetFrom(), uint64(*args.Nonce), nonce)
+	case validation && uint64(*args.Nonce) > nonce:
+		return fmt.Errorf("nonce too high: address %s, tx: %d state: %d", args.GetFrom(), uint64(*args.Nonce), nonce)
+	}
+
+	if args.Gas == nil {
+		args.Gas = (*hexutil.Uint64)(&gasLeft)
+	} else if uint64(*args.Gas) > gasLeft {
+		return fmt.Errorf("block gas limit reached: %d > %d", uint64(*args.Gas), gasLeft)
+	}
+
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(chainID)
+	}
+	return nil
+}
+
+// validateSimulatedCall checks that the fee cap of a simulated call covers the
+// base fee of the block, and that its sender can pay for the gas and the value
+// of the call.
+func validateSimulatedCall(stateDB *statedb.StateDB, msg ethtypes.Message, baseFee *big.Int) error {
+	if msg.GasFeeCap().Cmp(baseFee) < 0 {
+		return fmt.Errorf("max fee per gas less than block base fee: address %s, maxFeePerGas: %s, baseFee: %s",
+			msg.From(), msg.GasFeeCap(), baseFee)
+	}
+
+	cost := new(big.Int).Mul(msg.GasFeeCap(), new(big.Int).SetUint64(msg.Gas()))
+	cost.Add(cost, msg.Value())
+	if balance := stateDB.GetBalance(msg.From()); balance.Cmp(cost) < 0 {
+		return fmt.Errorf("insufficient funds for gas * price + value: address %s have %s want %s",
+			msg.From(), balance, cost)
+	}
+	return nil
+}
+
+// transferTracer adds a log emitted by the TransferLogAddress for every value
+// transfer of a simulated call, in the format of an ERC-20 Transfer event. The
+// logs are added to the StateDB so that they are reverted along with the call
+// frame transferring the value.
+type transferTracer struct {
+	types.NoOpTracer
+	stateDB vm.StateDB
+}
+
+// newTransferTracer returns a tracer adding the logs of the value transfers to
+// the given StateDB.
+func newTransferTracer(stateDB vm.StateDB) *transferTracer {
+	return &transferTracer{stateDB: stateDB}
+}
+
+// CaptureStart implements vm.EVMLogger.
+func (t *transferTracer) CaptureStart(_ *vm.EVM, from, to common.Address, _ bool, _ []byte, _ uint64, value *big.Int) {
+	t.addTransferLog(from, to, value)
+}
+
+// CaptureEnter implements vm.EVMLogger.
+func (t *transferTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	// delegate and static calls cannot transfer value
+	if typ == vm.DELEGATECALL || typ == vm.STATICCALL {
+		return
+	}
+	t.addTransferLog(from, to, value)
+}
+
+// addTransferLog adds the log of the given value transfer, if any.
+func (t *transferTracer) addTransferLog(from, to common.Address, value *big.Int) {
+	if value == nil || value.Sign() <= 0 {
+		return
+	}
+	t.stateDB.AddLog(&ethtypes.Log{
+		Address: types.TransferLogAddress,
+		Topics: []common.Hash{
+			types.TransferLogTopic,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.BigToHash(value).Bytes(),
+	})
+}
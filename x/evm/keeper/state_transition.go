@@ -261,7 +261,12 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLo
 //
 // # Commit parameter
 //
-// If commit is true, the `StateDB` will be committed, otherwise discarded.
+// If commit is true, the `StateDB` will be committed, otherwise discarded. The commit hook of the EVM config,
+// if any, is called with the hash of the dirty states before committing them.
+//
+// # State overrides
+//
+// If the EVM config contains state overrides, they are applied onto the `StateDB` before the message execution.
 func (k *Keeper) ApplyMessageWithConfig(
 	ctx sdk.Context,
 	msg core.Message,
@@ -269,13 +274,33 @@ func (k *Keeper) ApplyMessageWithConfig(
 	commit bool,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	stateDB := statedb.New(ctx, k, txConfig)
+	if cfg.Overrides != nil {
+		if err := stateDB.ApplyStateOverrides(cfg.Overrides); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+		}
+	}
+	return k.applyMessageWithStateDB(ctx, msg, tracer, commit, cfg, txConfig, stateDB)
+}
+
+// applyMessageWithStateDB applies the given message against the state of the
+// given StateDB. The state overrides of the EVM config are not applied, the
+// caller is responsible for preparing the StateDB.
+func (k *Keeper) applyMessageWithStateDB(
+	ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	stateDB *statedb.StateDB,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
-	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -353,6 +378,9 @@ func (k *Keeper) ApplyMessageWithConfig(
 
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
+		if cfg.CommitHook != nil {
+			cfg.CommitHook(stateDB.DirtyHash())
+		}
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
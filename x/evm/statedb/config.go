@@ -47,4 +47,11 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides are applied onto the StateDB before executing a message.
+	// They are only set on simulated calls (e.g. eth_call).
+	Overrides types.StateOverride
+	// CommitHook, if set, is called with the hash of the dirty states of the
+	// StateDB right before they are committed. It is only used to compute the
+	// intermediate state commitments of a block (e.g. debug_intermediateRoots).
+	CommitHook func(dirtyHash common.Hash)
 }
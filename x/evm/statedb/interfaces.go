@@ -26,6 +26,9 @@ type Keeper interface {
 	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
 	// the callback returns false to break early
 	ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool)
+	// HasFractionalBalances returns true if the balances are stored with 18
+	// decimals precision, so the amounts don't need to be truncated
+	HasFractionalBalances() bool
 
 	// Write methods, only called by `StateDB.Commit()`
 	SetAccount(ctx sdk.Context, addr common.Address, account Account) error
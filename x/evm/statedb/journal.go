@@ -120,6 +120,11 @@ type (
 		account       *common.Address
 		key, prevalue common.Hash
 	}
+	storageOverrideChange struct {
+		account               *common.Address
+		prevorigin, prevdirty Storage
+		prevoverridden        bool
+	}
 	codeChange struct {
 		account            *common.Address
 		prevcode, prevhash []byte
@@ -152,6 +157,7 @@ var (
 	_ JournalEntry = balanceChange{}
 	_ JournalEntry = nonceChange{}
 	_ JournalEntry = storageChange{}
+	_ JournalEntry = storageOverrideChange{}
 	_ JournalEntry = codeChange{}
 	_ JournalEntry = refundChange{}
 	_ JournalEntry = addLogChange{}
@@ -276,3 +282,14 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch storageOverrideChange) Revert(s *StateDB) {
+	obj := s.getStateObject(*ch.account)
+	obj.originStorage = ch.prevorigin
+	obj.dirtyStorage = ch.prevdirty
+	obj.overriddenStorage = ch.prevoverridden
+}
+
+func (ch storageOverrideChange) Dirtied() *common.Address {
+	return ch.account
+}
@@ -61,6 +61,10 @@ func (k MockKeeper) ForEachStorage(_ sdk.Context, addr common.Address, cb func(k
 	}
 }
 
+func (k MockKeeper) HasFractionalBalances() bool {
+	return false
+}
+
 func (k MockKeeper) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
 	if addr == errAddress {
 		return errors.New("mock db error")
@@ -0,0 +1,42 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package statedb
+
+import (
+	"github.com/evmos/os/x/evm/types"
+)
+
+// ApplyStateOverrides overrides the fields of the specified accounts in the
+// StateDB. It mirrors the go-ethereum implementation used by eth_call and is
+// meant to be used only on simulated message calls.
+func (s *StateDB) ApplyStateOverrides(overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	for addr, account := range overrides {
+		// Override account nonce.
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce))
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil && *account.Balance != nil {
+			s.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			s.SetStorage(addr, *account.State)
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
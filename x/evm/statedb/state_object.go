@@ -60,6 +60,9 @@ type stateObject struct {
 	// state storage
 	originStorage Storage
 	dirtyStorage  Storage
+	// overriddenStorage is set when the whole committed storage of the account
+	// has been replaced through SetStorage, so no state is loaded from keeper.
+	overriddenStorage bool
 
 	address common.Address
 
@@ -101,7 +104,7 @@ func (s *stateObject) markSuicided() {
 // AddBalance adds amount to s's balance.
 // It is used to add funds to the destination account of a transfer.
 func (s *stateObject) AddBalance(amount *big.Int) {
-	amount = types.AdjustExtraDecimalsBigInt(amount)
+	amount = s.adjustAmount(amount)
 	if amount.Sign() == 0 {
 		return
 	}
@@ -111,13 +114,22 @@ func (s *stateObject) AddBalance(amount *big.Int) {
 // SubBalance removes amount from s's balance.
 // It is used to remove funds from the origin account of a transfer.
 func (s *stateObject) SubBalance(amount *big.Int) {
-	amount = types.AdjustExtraDecimalsBigInt(amount)
+	amount = s.adjustAmount(amount)
 	if amount.Sign() == 0 {
 		return
 	}
 	s.SetBalance(new(big.Int).Sub(s.Balance(), amount))
 }
 
+// adjustAmount removes the extra decimals of the amount that cannot be stored
+// when the keeper does not track fractional balances.
+func (s *stateObject) adjustAmount(amount *big.Int) *big.Int {
+	if s.db.keeper.HasFractionalBalances() {
+		return amount
+	}
+	return types.AdjustExtraDecimalsBigInt(amount)
+}
+
 // SetBalance update account balance.
 func (s *stateObject) SetBalance(amount *big.Int) {
 	s.db.journal.append(balanceChange{
@@ -222,6 +234,10 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	// If the storage has been overridden, the missing slots are empty
+	if s.overriddenStorage {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -255,3 +271,20 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire committed storage of the account with the
+// given one.
+func (s *stateObject) SetStorage(storage Storage) {
+	s.db.journal.append(storageOverrideChange{
+		account:        &s.address,
+		prevorigin:     s.originStorage,
+		prevdirty:      s.dirtyStorage,
+		prevoverridden: s.overriddenStorage,
+	})
+	s.originStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.originStorage[key] = value
+	}
+	s.dirtyStorage = make(Storage)
+	s.overriddenStorage = true
+}
@@ -322,6 +322,21 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.overriddenStorage {
+		storage := make(Storage, len(so.originStorage)+len(so.dirtyStorage))
+		for key, value := range so.originStorage {
+			storage[key] = value
+		}
+		for key, value := range so.dirtyStorage {
+			storage[key] = value
+		}
+		for _, key := range storage.SortedKeys() {
+			if !cb(key, storage[key]) {
+				break
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -374,6 +389,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -398,6 +421,15 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage of the account with the given one.
+// It is meant to be used only to apply state overrides on simulated calls.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -515,6 +547,54 @@ func (s *StateDB) Commit() error {
 	return s.commitWithCtx(s.ctx)
 }
 
+// PrepareTx writes the pending changes to the context of the StateDB and
+// resets the transaction scoped state, using the given config for the next
+// transaction. It allows to execute a sequence of simulated transactions, each
+// one on top of the state left by the previous ones, with the same StateDB.
+func (s *StateDB) PrepareTx(txConfig TxConfig) error {
+	if err := s.Commit(); err != nil {
+		return err
+	}
+
+	s.cacheCtx, s.writeCache = sdk.Context{}, nil
+	s.journal = newJournal()
+	s.validRevisions = s.validRevisions[:0]
+	s.stateObjects = make(map[common.Address]*stateObject)
+	s.txConfig = txConfig
+	s.refund = 0
+	s.logs = nil
+	s.accessList = newAccessList()
+	s.precompileCallsCounter = 0
+	return nil
+}
+
+// DirtyHash returns a deterministic commitment of the dirty states that are
+// written to the keeper on Commit. The accounts and their storage slots are
+// hashed in sorted order.
+func (s *StateDB) DirtyHash() common.Hash {
+	var data [][]byte
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		data = append(data, addr.Bytes())
+		if obj.suicided {
+			data = append(data, []byte{1})
+			continue
+		}
+
+		data = append(data,
+			[]byte{0},
+			sdk.Uint64ToBigEndian(obj.account.Nonce),
+			common.BigToHash(obj.account.Balance).Bytes(),
+			obj.account.CodeHash,
+		)
+		for _, key := range obj.dirtyStorage.SortedKeys() {
+			value := obj.dirtyStorage[key]
+			data = append(data, key.Bytes(), value.Bytes())
+		}
+	}
+	return crypto.Keccak256Hash(data...)
+}
+
 // CommitWithCacheCtx writes the dirty states to keeper using the cacheCtx.
 // This function is used before any precompile call to make sure the cacheCtx
 // is updated with the latest changes within the tx (StateDB's journal entries).
@@ -543,6 +623,25 @@ func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 
+			if obj.overriddenStorage {
+				// drop the persisted storage and write the overridden one
+				var keys []common.Hash
+				s.keeper.ForEachStorage(ctx, obj.Address(), func(key, _ common.Hash) bool {
+					keys = append(keys, key)
+					return true
+				})
+				for _, key := range keys {
+					s.keeper.DeleteState(ctx, obj.Address(), key)
+				}
+				for _, key := range obj.originStorage.SortedKeys() {
+					value := obj.originStorage[key]
+					if _, dirty := obj.dirtyStorage[key]; dirty || value == (common.Hash{}) {
+						continue
+					}
+					s.keeper.SetState(ctx, obj.Address(), key, value.Bytes())
+				}
+			}
+
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				valueBytes := obj.dirtyStorage[key].Bytes()
 				if len(valueBytes) == 0 {
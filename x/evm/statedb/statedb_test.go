@@ -6,10 +6,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/os/x/evm/core/vm"
 	"github.com/evmos/os/x/evm/statedb"
+	"github.com/evmos/os/x/evm/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -569,6 +571,136 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestDirtyHash() {
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+
+	newDB := func() *statedb.StateDB {
+		return statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+	}
+
+	// no state changes
+	emptyHash := newDB().DirtyHash()
+
+	// the hash doesn't depend on the order of the state changes
+	db1 := newDB()
+	db1.SetState(address, key, value)
+	db1.AddBalance(address2, big.NewInt(10))
+	db2 := newDB()
+	db2.AddBalance(address2, big.NewInt(10))
+	db2.SetState(address, key, value)
+	suite.Require().NotEqual(emptyHash, db1.DirtyHash())
+	suite.Require().Equal(db1.DirtyHash(), db2.DirtyHash())
+
+	// different state changes lead to different hashes
+	db3 := newDB()
+	db3.SetState(address, key, common.BigToHash(big.NewInt(3)))
+	db3.AddBalance(address2, big.NewInt(10))
+	suite.Require().NotEqual(db1.DirtyHash(), db3.DirtyHash())
+
+	// reverted changes are not included
+	db4 := newDB()
+	snapshot := db4.Snapshot()
+	db4.SetState(address, key, value)
+	db4.RevertToSnapshot(snapshot)
+	suite.Require().Equal(emptyHash, db4.DirtyHash())
+}
+
+func (suite *StateDBTestSuite) TestApplyStateOverrides() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	key2 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+	value3 := common.BigToHash(big.NewInt(5))
+
+	nonce := hexutil.Uint64(7)
+	code := hexutil.Bytes("hello world")
+	balance := (*hexutil.Big)(big.NewInt(1000))
+
+	testCases := []struct {
+		name      string
+		overrides func() types.StateOverride
+		expPass   bool
+		check     func(db *statedb.StateDB)
+	}{
+		{
+			"pass - override nonce, code and balance",
+			func() types.StateOverride {
+				return types.StateOverride{
+					address: types.OverrideAccount{Nonce: &nonce, Code: &code, Balance: &balance},
+				}
+			},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(uint64(7), db.GetNonce(address))
+				suite.Require().Equal([]byte(code), db.GetCode(address))
+				suite.Require().Equal(big.NewInt(1000), db.GetBalance(address))
+				// the storage is kept
+				suite.Require().Equal(value1, db.GetState(address, key1))
+			},
+		},
+		{
+			"pass - state diff is applied on top of the storage",
+			func() types.StateOverride {
+				diff := map[common.Hash]common.Hash{key2: value3}
+				return types.StateOverride{address: types.OverrideAccount{StateDiff: &diff}}
+			},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(value1, db.GetState(address, key1))
+				suite.Require().Equal(value3, db.GetState(address, key2))
+			},
+		},
+		{
+			"pass - state replaces the whole storage",
+			func() types.StateOverride {
+				state := map[common.Hash]common.Hash{key2: value3}
+				return types.StateOverride{address: types.OverrideAccount{State: &state}}
+			},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+				suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+				suite.Require().Equal(value3, db.GetState(address, key2))
+				suite.Require().Equal(statedb.Storage{key2: value3}, CollectContractStorage(db))
+
+				// the overridden storage replaces the persisted one on commit
+				suite.Require().NoError(db.Commit())
+				keeper := db.Keeper().(*MockKeeper)
+				suite.Require().Equal(statedb.Storage{key2: value3}, keeper.accounts[address].states)
+			},
+		},
+		{
+			"fail - both state and state diff",
+			func() types.StateOverride {
+				state := map[common.Hash]common.Hash{key2: value3}
+				return types.StateOverride{address: types.OverrideAccount{State: &state, StateDiff: &state}}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := NewMockKeeper()
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			db.SetState(address, key1, value1)
+			db.SetState(address, key2, value2)
+			suite.Require().NoError(db.Commit())
+
+			db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			err := db.ApplyStateOverrides(tc.overrides())
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.check(db)
+		})
+	}
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	err := db.ForEachStorage(address, func(k, v common.Hash) bool {
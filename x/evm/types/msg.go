@@ -325,6 +325,17 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return BuildTxWithMsgs(b, evmDenom, msg)
+}
+
+// BuildTxWithMsgs builds a canonical cosmos tx that batches the given ethereum
+// msgs. The fee amount and gas limit of the tx are the sums of the fees and
+// gas limits of all the msgs.
+func BuildTxWithMsgs(b client.TxBuilder, evmDenom string, msgs ...*MsgEthereumTx) (signing.Tx, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no ethereum msgs provided")
+	}
+
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
@@ -335,28 +346,36 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 		return nil, err
 	}
 
-	txData, err := UnpackTxData(msg.Data)
-	if err != nil {
-		return nil, err
+	var gasLimit uint64
+	feeAmt := new(big.Int)
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		txData, err := UnpackTxData(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		feeAmt.Add(feeAmt, txData.Fee())
+		gasLimit += msg.GetGas()
+
+		// A valid msg should have empty `From`
+		msg.From = ""
+		sdkMsgs[i] = msg
 	}
+
 	fees := make(sdk.Coins, 0, 1)
-	feeAmt := sdkmath.NewIntFromBigInt(txData.Fee())
 	if feeAmt.Sign() > 0 {
-		fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
+		fees = append(fees, sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(feeAmt)))
 		fees = ConvertCoinsFrom18Decimals(fees)
 	}
 
 	builder.SetExtensionOptions(option)
 
-	// A valid msg should have empty `From`
-	msg.From = ""
-
-	err = builder.SetMsgs(msg)
+	err = builder.SetMsgs(sdkMsgs...)
 	if err != nil {
 		return nil, err
 	}
 	builder.SetFeeAmount(fees)
-	builder.SetGasLimit(msg.GetGas())
+	builder.SetGasLimit(gasLimit)
 	tx := builder.GetTx()
 	return tx, nil
 }
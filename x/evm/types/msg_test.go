@@ -147,6 +147,37 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTx() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestBuildTxWithMsgs() {
+	evmTx := &types.EvmTxArgs{
+		Nonce:     0,
+		To:        &suite.to,
+		GasLimit:  100000,
+		GasPrice:  big.NewInt(1e18),
+		GasFeeCap: big.NewInt(1e18),
+		GasTipCap: big.NewInt(0),
+		Input:     []byte("test"),
+	}
+	evmTx2 := *evmTx
+	evmTx2.Nonce = 1
+	evmTx2.GasLimit = 50000
+
+	baseDenom := types.GetEVMCoinDenom()
+
+	_, err := types.BuildTxWithMsgs(suite.clientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	suite.Require().Error(err)
+
+	msgs := []*types.MsgEthereumTx{types.NewTx(evmTx), types.NewTx(&evmTx2)}
+	tx, err := types.BuildTxWithMsgs(suite.clientCtx.TxConfig.NewTxBuilder(), baseDenom, msgs...)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(tx.GetMsgs(), 2)
+	suite.Require().Equal(uint64(150000), tx.GetGas())
+
+	expFeeAmt := sdkmath.NewIntFromBigInt(evmTx.GasPrice).MulRaw(150000)
+	expFee := types.ConvertCoinsFrom18Decimals(sdk.NewCoins(sdk.NewCoin(baseDenom, expFeeAmt)))
+	suite.Require().Equal(expFee, tx.GetFee())
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 	var (
 		hundredInt   = big.NewInt(100)
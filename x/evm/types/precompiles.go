@@ -17,6 +17,8 @@ const (
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
 	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
 	EvidencePrecompileAddress     = "0x0000000000000000000000000000000000000807"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000808"
+	FeeGrantPrecompileAddress     = "0x0000000000000000000000000000000000000809"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -34,4 +36,6 @@ var AvailableStaticPrecompiles = []string{
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
 	EvidencePrecompileAddress,
+	AuthzPrecompileAddress,
+	FeeGrantPrecompileAddress,
 }
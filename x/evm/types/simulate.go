@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks, including the ones
+	// filling the gaps between the requested block numbers, that can be
+	// simulated by a single eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+	// SimulateTimestampIncrement is the default number of seconds between two
+	// simulated blocks.
+	SimulateTimestampIncrement = 12
+)
+
+var (
+	// TransferLogAddress is the address emitting the logs of the value
+	// transfers traced by eth_simulateV1.
+	TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// TransferLogTopic is the topic of the ERC-20 Transfer event, used for the
+	// logs of the value transfers traced by eth_simulateV1.
+	TransferLogTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// SimOpts is the set of blocks of calls and the options of an eth_simulateV1
+// request.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a block of calls executed in order on top of the state left by
+// the previous simulated blocks and the given state overrides.
+type SimBlock struct {
+	BlockOverrides *SimBlockOverrides `json:"blockOverrides"`
+	StateOverrides StateOverride      `json:"stateOverrides"`
+	Calls          []TransactionArgs  `json:"calls"`
+}
+
+// SimBlockOverrides is the set of header fields of a simulated block. The
+// fields that are not set are derived from the previous block.
+type SimBlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Validate performs a stateless validation of the simulation options.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	for i, block := range opts.BlockStateCalls {
+		if err := block.StateOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+		if err := block.BlockOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the block overrides.
+func (diff *SimBlockOverrides) Validate() error {
+	if diff == nil {
+		return nil
+	}
+	if diff.Number != nil && (diff.Number.ToInt().Sign() < 0 || !diff.Number.ToInt().IsInt64()) {
+		return fmt.Errorf("invalid block number override: %s", diff.Number)
+	}
+	if diff.Time != nil && uint64(*diff.Time) > math.MaxInt64 {
+		return fmt.Errorf("block time override overflows int64: %d", uint64(*diff.Time))
+	}
+	if diff.GasLimit != nil && uint64(*diff.GasLimit) > math.MaxInt64 {
+		return fmt.Errorf("block gas limit override overflows int64: %d", uint64(*diff.GasLimit))
+	}
+	if diff.BaseFeePerGas != nil && diff.BaseFeePerGas.ToInt().Sign() < 0 {
+		return fmt.Errorf("base fee override cannot be negative: %s", diff.BaseFeePerGas)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimOptsValidate(t *testing.T) {
+	tooLarge := hexutil.Uint64(math.MaxUint64)
+	code := hexutil.Bytes{0x1}
+	slots := map[common.Hash]common.Hash{}
+
+	testCases := []struct {
+		name    string
+		opts    SimOpts
+		expPass bool
+	}{
+		{
+			"valid options",
+			SimOpts{BlockStateCalls: []SimBlock{
+				{BlockOverrides: &SimBlockOverrides{Number: (*hexutil.Big)(big.NewInt(10))}},
+				{StateOverrides: StateOverride{common.Address{}: OverrideAccount{Code: &code}}},
+			}},
+			true,
+		},
+		{
+			"no blocks",
+			SimOpts{},
+			false,
+		},
+		{
+			"too many blocks",
+			SimOpts{BlockStateCalls: make([]SimBlock, MaxSimulateBlocks+1)},
+			false,
+		},
+		{
+			"negative block number",
+			SimOpts{BlockStateCalls: []SimBlock{
+				{BlockOverrides: &SimBlockOverrides{Number: (*hexutil.Big)(big.NewInt(-1))}},
+			}},
+			false,
+		},
+		{
+			"block time overflow",
+			SimOpts{BlockStateCalls: []SimBlock{
+				{BlockOverrides: &SimBlockOverrides{Time: &tooLarge}},
+			}},
+			false,
+		},
+		{
+			"gas limit overflow",
+			SimOpts{BlockStateCalls: []SimBlock{
+				{BlockOverrides: &SimBlockOverrides{GasLimit: &tooLarge}},
+			}},
+			false,
+		},
+		{
+			"negative base fee",
+			SimOpts{BlockStateCalls: []SimBlock{
+				{BlockOverrides: &SimBlockOverrides{BaseFeePerGas: (*hexutil.Big)(big.NewInt(-1))}},
+			}},
+			false,
+		},
+		{
+			"invalid state overrides",
+			SimOpts{BlockStateCalls: []SimBlock{
+				{StateOverrides: StateOverride{common.Address{}: OverrideAccount{State: &slots, StateDiff: &slots}}},
+			}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.opts.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
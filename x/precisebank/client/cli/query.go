@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// GetQueryCmd returns the parent command for all x/precisebank CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the precisebank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetRemainderCmd(),
+		GetFractionalBalanceCmd(),
+	)
+	return cmd
+}
+
+// GetRemainderCmd queries the remainder amount held by the reserve
+func GetRemainderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remainder",
+		Short: "Get the remainder amount held by the reserve",
+		Long:  "Get the amount of the evm coin, in 18 decimals, backed by the reserve and not assigned to any account.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Remainder(cmd.Context(), &types.QueryRemainderRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFractionalBalanceCmd queries the fractional balance of an account
+func GetFractionalBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fractional-balance ADDRESS",
+		Short: "Get the fractional balance of an account",
+		Long:  "Get the fractional part of the evm coin balance of an account, in 18 decimals.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFractionalBalanceRequest{Address: args[0]}
+			res, err := queryClient.FractionalBalance(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
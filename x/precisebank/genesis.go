@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package precisebank
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/keeper"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis. It panics
+// if the reserve balance does not back the fractional balances and the
+// remainder.
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := data.Validate(); err != nil {
+		panic(errorsmod.Wrap(err, "invalid precisebank genesis state"))
+	}
+
+	// ensure the reserve module account is set
+	reserveAcc := ak.GetModuleAccount(ctx, types.ModuleName)
+	if reserveAcc == nil {
+		panic("the precisebank module account has not been set")
+	}
+
+	for _, fb := range data.Balances {
+		k.SetFractionalBalance(ctx, sdk.MustAccAddressFromBech32(fb.Address), fb.Amount)
+	}
+	k.SetRemainderAmount(ctx, data.Remainder)
+
+	reserveBalance := bk.GetBalance(ctx, reserveAcc.GetAddress(), evmtypes.GetEVMCoinDenom()).Amount
+	expected := data.TotalAmountWithRemainder().Quo(types.ConversionFactor())
+	if !reserveBalance.Equal(expected) {
+		panic(fmt.Sprintf(
+			"reserve balance %s does not match the expected %s for the fractional balances and remainder",
+			reserveBalance, expected,
+		))
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the precisebank module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	balances := types.FractionalBalances{}
+	k.IterateFractionalBalances(ctx, func(addr sdk.AccAddress, amt sdkmath.Int) bool {
+		balances = append(balances, types.NewFractionalBalance(addr.String(), amt))
+		return false
+	})
+
+	return types.NewGenesisState(balances, k.GetRemainderAmount(ctx))
+}
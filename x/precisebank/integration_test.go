@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package precisebank_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testconstants "github.com/evmos/os/testutil/constants"
+	"github.com/evmos/os/testutil/integration/os/factory"
+	"github.com/evmos/os/testutil/integration/os/grpc"
+	testkeyring "github.com/evmos/os/testutil/integration/os/keyring"
+	"github.com/evmos/os/testutil/integration/os/network"
+	utiltx "github.com/evmos/os/testutil/tx"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/keeper"
+	"github.com/evmos/os/x/precisebank/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEVMTransferFractionalAmount checks that the example chain handles the
+// amounts of the EVM with 18 decimals precision when the evm coin uses 6
+// decimals in x/bank.
+func TestEVMTransferFractionalAmount(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.NewUnitTestNetwork(
+		network.WithChainID(testconstants.SixDecimalsChainID),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, handler)
+
+	require.True(t, nw.App.EVMKeeper.HasFractionalBalances())
+	cf := types.ConversionFactor()
+	require.Equal(t, sdkmath.NewInt(1e12), cf)
+
+	// one integer unit and one wei, that cannot be represented in x/bank
+	amount := cf.AddRaw(1)
+	sender := keyring.GetKey(0)
+	receiverAddr, _ := utiltx.NewAddrKey()
+	receiver := sdk.AccAddress(receiverAddr.Bytes())
+
+	res, err := txFactory.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{
+		To:     &receiverAddr,
+		Amount: amount.BigInt(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), "transaction should have succeeded", res.GetLog())
+	require.NoError(t, nw.NextBlock())
+
+	ctx := nw.GetContext()
+
+	// the EVM sees the full amount
+	evmBalance, err := handler.GetBalanceFromEVM(receiver)
+	require.NoError(t, err)
+	require.Equal(t, amount.String(), evmBalance.Balance)
+
+	// x/bank holds the integer part and x/precisebank the fractional part
+	bankBalance, err := handler.GetBalanceFromBank(receiver, nw.GetBaseDenom())
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.OneInt(), bankBalance.Balance.Amount)
+	require.Equal(t, sdkmath.OneInt(), nw.App.PreciseBankKeeper.GetFractionalBalance(ctx, receiver))
+
+	// the gas refund is paid in 18 decimals too, so the sender balance in the
+	// EVM is made of its integer and fractional parts
+	senderEVMBalance, err := handler.GetBalanceFromEVM(sender.AccAddr)
+	require.NoError(t, err)
+	senderBankBalance := nw.App.BankKeeper.GetBalance(ctx, sender.AccAddr, nw.GetBaseDenom())
+	senderFractional := nw.App.PreciseBankKeeper.GetFractionalBalance(ctx, sender.AccAddr)
+	require.False(t, senderFractional.IsZero())
+	require.Equal(t, senderBankBalance.Amount.Mul(cf).Add(senderFractional).String(), senderEVMBalance.Balance)
+
+	// the reserve backs the fractional balances
+	msg, broken := keeper.AllInvariants(nw.App.PreciseBankKeeper)(ctx)
+	require.False(t, broken, msg)
+
+	// sending the complement of the receiver fractional balance carries it
+	// over into an integer unit
+	res, err = txFactory.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{
+		To:     &receiverAddr,
+		Amount: cf.SubRaw(1).BigInt(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), "transaction should have succeeded", res.GetLog())
+	require.NoError(t, nw.NextBlock())
+
+	ctx = nw.GetContext()
+	bankBalance, err = handler.GetBalanceFromBank(receiver, nw.GetBaseDenom())
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(2), bankBalance.Balance.Amount)
+	require.True(t, nw.App.PreciseBankKeeper.GetFractionalBalance(ctx, receiver).IsZero())
+	msg, broken = keeper.AllInvariants(nw.App.PreciseBankKeeper)(ctx)
+	require.False(t, broken, msg)
+}
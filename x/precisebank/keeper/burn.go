@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// BurnCoins burns the given coins from the given module account. The amount
+// of the evm coin is expected in 18 decimals. It panics if the module account
+// does not exist or is not allowed to burn.
+func (k Keeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	passthrough, extendedAmt := splitCoins(amt)
+	if extendedAmt.IsZero() {
+		return k.bk.BurnCoins(ctx, moduleName, passthrough)
+	}
+
+	if !amt.IsValid() {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, amt.String())
+	}
+
+	acc := k.ak.GetModuleAccount(ctx, moduleName)
+	if acc == nil {
+		panic(errorsmod.Wrapf(errortypes.ErrUnknownAddress, "module account %s does not exist", moduleName))
+	}
+
+	if !acc.HasPermission(authtypes.Burner) {
+		panic(errorsmod.Wrapf(errortypes.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
+	}
+
+	if !passthrough.IsZero() {
+		if err := k.bk.BurnCoins(ctx, moduleName, passthrough); err != nil {
+			return err
+		}
+	}
+
+	return k.burnExtendedCoin(sdk.UnwrapSDKContext(ctx), moduleName, acc.GetAddress(), extendedAmt)
+}
+
+// BurnAmountFromAccount burns the given amount of the evm coin, in 18
+// decimals, from the provided account.
+func (k Keeper) BurnAmountFromAccount(ctx context.Context, account sdk.AccAddress, amt *big.Int) error {
+	coins := sdk.Coins{{Denom: evmtypes.GetEVMCoinDenom(), Amount: sdkmath.NewIntFromBigInt(amt)}}
+
+	if err := k.SendCoinsFromAccountToModule(ctx, account, evmtypes.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(err, "failed to burn coins from account in precisebank")
+	}
+
+	return k.BurnCoins(ctx, evmtypes.ModuleName, coins)
+}
+
+// burnExtendedCoin burns an amount of the evm coin in 18 decimals. The integer
+// part is burned through x/bank, while the fractional part is added to the
+// remainder. An integer unit is moved from the module to the reserve when its
+// fractional balance is not enough, and an integer unit is burned from the
+// reserve when the remainder overflows.
+func (k Keeper) burnExtendedCoin(ctx sdk.Context, moduleName string, moduleAddr sdk.AccAddress, amt sdkmath.Int) error {
+	denom := evmtypes.GetEVMCoinDenom()
+	if balance := k.GetBalance(ctx, moduleAddr, denom).Amount; balance.LT(amt) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"spendable balance %s%s is smaller than %s%s", balance, denom, amt, denom,
+		)
+	}
+
+	cf := types.ConversionFactor()
+	integerAmt, fractionalAmt := types.SplitAmount(amt)
+
+	if fractionalAmt.IsPositive() {
+		moduleFractional := k.GetFractionalBalance(ctx, moduleAddr).Sub(fractionalAmt)
+		moduleBorrows := moduleFractional.IsNegative()
+		if moduleBorrows {
+			moduleFractional = moduleFractional.Add(cf)
+		}
+
+		remainder := k.GetRemainderAmount(ctx).Add(fractionalAmt)
+		reserveBurns := remainder.GTE(cf)
+		if reserveBurns {
+			remainder = remainder.Sub(cf)
+		}
+
+		unit := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.OneInt()))
+		switch {
+		case moduleBorrows && reserveBurns:
+			// the unit moved to the reserve would be burned, so it is burned
+			// directly from the module
+			integerAmt = integerAmt.AddRaw(1)
+		case moduleBorrows:
+			if err := k.bk.SendCoinsFromModuleToModule(ctx, moduleName, types.ModuleName, unit); err != nil {
+				return errorsmod.Wrap(err, "failed to borrow integer unit from module")
+			}
+		case reserveBurns:
+			if err := k.bk.BurnCoins(ctx, types.ModuleName, unit); err != nil {
+				return errorsmod.Wrap(err, "failed to burn integer unit from reserve")
+			}
+		}
+
+		k.SetFractionalBalance(ctx, moduleAddr, moduleFractional)
+		k.SetRemainderAmount(ctx, remainder)
+	}
+
+	if integerAmt.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, integerAmt))
+		if err := k.bk.BurnCoins(ctx, moduleName, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
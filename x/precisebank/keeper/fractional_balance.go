@@ -0,0 +1,124 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// GetFractionalBalance returns the fractional balance of the given address,
+// in 18 decimals. It returns zero if the account has no fractional balance.
+func (k Keeper) GetFractionalBalance(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+
+	bz := store.Get(types.FractionalBalanceKey(addr))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var amt sdkmath.Int
+	if err := amt.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal fractional balance of %s: %w", addr, err))
+	}
+	return amt
+}
+
+// SetFractionalBalance sets the fractional balance of the given address. A
+// zero amount deletes the balance from the store. It panics if the amount is
+// not a valid fractional amount.
+func (k Keeper) SetFractionalBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+	key := types.FractionalBalanceKey(addr)
+
+	if amt.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	if err := types.ValidateFractionalAmount(amt); err != nil {
+		panic(fmt.Errorf("invalid fractional balance for %s: %w", addr, err))
+	}
+
+	bz, err := amt.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal fractional balance of %s: %w", addr, err))
+	}
+	store.Set(key, bz)
+}
+
+// IterateFractionalBalances iterates over all the fractional balances in the
+// store. The callback returns true to stop the iteration.
+func (k Keeper) IterateFractionalBalances(ctx sdk.Context, cb func(addr sdk.AccAddress, amt sdkmath.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFractionalBalance)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed address
+		addr := sdk.AccAddress(iterator.Key()[1:])
+
+		var amt sdkmath.Int
+		if err := amt.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("failed to unmarshal fractional balance of %s: %w", addr, err))
+		}
+
+		if cb(addr, amt) {
+			break
+		}
+	}
+}
+
+// GetTotalSumFractionalBalances returns the sum of all the fractional
+// balances in the store.
+func (k Keeper) GetTotalSumFractionalBalances(ctx sdk.Context) sdkmath.Int {
+	sum := sdkmath.ZeroInt()
+	k.IterateFractionalBalances(ctx, func(_ sdk.AccAddress, amt sdkmath.Int) bool {
+		sum = sum.Add(amt)
+		return false
+	})
+	return sum
+}
+
+// GetRemainderAmount returns the amount backed by the reserve that is not
+// assigned to any account, in 18 decimals.
+func (k Keeper) GetRemainderAmount(ctx sdk.Context) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyRemainderAmount)
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var amt sdkmath.Int
+	if err := amt.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal remainder amount: %w", err))
+	}
+	return amt
+}
+
+// SetRemainderAmount sets the remainder amount. A zero amount deletes it from
+// the store. It panics if the amount is negative or not lower than the
+// conversion factor.
+func (k Keeper) SetRemainderAmount(ctx sdk.Context, amt sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+
+	if amt.IsZero() {
+		store.Delete(types.KeyRemainderAmount)
+		return
+	}
+
+	if amt.IsNegative() || amt.GTE(types.ConversionFactor()) {
+		panic(fmt.Errorf("invalid remainder amount %s", amt))
+	}
+
+	bz, err := amt.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal remainder amount: %w", err))
+	}
+	store.Set(types.KeyRemainderAmount, bz)
+}
@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Remainder implements the Query/Remainder gRPC method
+func (k Keeper) Remainder(c context.Context, req *types.QueryRemainderRequest) (*types.QueryRemainderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	remainder := k.GetRemainderAmount(ctx)
+
+	return &types.QueryRemainderResponse{
+		Remainder: sdk.NewCoin(evmtypes.GetEVMCoinDenom(), remainder),
+	}, nil
+}
+
+// FractionalBalance implements the Query/FractionalBalance gRPC method
+func (k Keeper) FractionalBalance(c context.Context, req *types.QueryFractionalBalanceRequest) (*types.QueryFractionalBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	amt := k.GetFractionalBalance(ctx, addr)
+
+	return &types.QueryFractionalBalanceResponse{
+		FractionalBalance: sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amt),
+	}, nil
+}
@@ -0,0 +1,104 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// RegisterInvariants registers the precisebank module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valid-fractional-balances", ValidFractionalBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-remainder-amount", ValidRemainderAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "fractional-balances-backed-by-reserve", ReserveBacksFractionsInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the precisebank module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ValidFractionalBalancesInvariant(k),
+			ValidRemainderAmountInvariant(k),
+			ReserveBacksFractionsInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ValidFractionalBalancesInvariant checks that all the stored fractional
+// balances are positive and lower than the conversion factor.
+func ValidFractionalBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateFractionalBalances(ctx, func(addr sdk.AccAddress, amt sdkmath.Int) bool {
+			if err := types.ValidateFractionalAmount(amt); err != nil {
+				msg = fmt.Sprintf("\tinvalid fractional balance for %s: %s\n", addr, err)
+				broken = true
+				return true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "valid-fractional-balances", msg), broken
+	}
+}
+
+// ValidRemainderAmountInvariant checks that the remainder amount is not
+// negative and lower than the conversion factor.
+func ValidRemainderAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		remainder := k.GetRemainderAmount(ctx)
+		if remainder.IsNegative() || remainder.GTE(types.ConversionFactor()) {
+			msg = fmt.Sprintf("\tinvalid remainder amount %s\n", remainder)
+			broken = true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "valid-remainder-amount", msg), broken
+	}
+}
+
+// ReserveBacksFractionsInvariant checks that the balance of the reserve, in 18
+// decimals, is equal to the sum of all the fractional balances and the
+// remainder, so that the total supply of the evm coin stays consistent.
+func ReserveBacksFractionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		reserveAddr := k.ak.GetModuleAddress(types.ModuleName)
+		reserveBalance := k.bk.GetBalance(ctx, reserveAddr, evmtypes.GetEVMCoinDenom()).Amount
+		reserveExtended := reserveBalance.Mul(types.ConversionFactor())
+
+		expected := k.GetTotalSumFractionalBalances(ctx).Add(k.GetRemainderAmount(ctx))
+		if !reserveExtended.Equal(expected) {
+			msg = fmt.Sprintf(
+				"\treserve balance %s mismatches the sum of fractional balances and remainder %s\n",
+				reserveExtended, expected,
+			)
+			broken = true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "fractional-balances-backed-by-reserve", msg), broken
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+var _ evmtypes.BankWrapper = Keeper{}
+
+// Keeper wraps the Cosmos SDK x/bank keeper to handle the evm coin with 18
+// decimals precision when the bank representation of the coin uses fewer
+// decimals. The integer part of each balance is stored in x/bank, while the
+// fractional remainder is stored by this module and backed by the integer
+// balance of the reserve module account.
+//
+// All amounts of the evm coin passed to and returned by the keeper are
+// expressed in 18 decimals, every other denom is passed through to x/bank.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the precisebank KVStore.
+	storeKey storetypes.StoreKey
+
+	bk types.BankKeeper
+	ak types.AccountKeeper
+}
+
+// NewKeeper generates a new precisebank module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	bk types.BankKeeper,
+	ak types.AccountKeeper,
+) Keeper {
+	// ensure the reserve module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the precisebank module account has not been set")
+	}
+
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		bk:       bk,
+		ak:       ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// isFractionalDenom returns true if the amounts of the given denom can have a
+// fractional part that has to be tracked by the keeper.
+func isFractionalDenom(denom string) bool {
+	return denom == evmtypes.GetEVMCoinDenom() && !types.ConversionFactor().Equal(sdkmath.OneInt())
+}
+
+// splitCoins splits the given coins into the amount of the evm coin that has to
+// be handled with 18 decimals precision and the remaining coins that are
+// passed through to x/bank.
+func splitCoins(coins sdk.Coins) (passthrough sdk.Coins, extendedAmt sdkmath.Int) {
+	extendedAmt = sdkmath.ZeroInt()
+	passthrough = sdk.NewCoins()
+	for _, coin := range coins {
+		if isFractionalDenom(coin.Denom) {
+			extendedAmt = coin.Amount
+			continue
+		}
+		passthrough = passthrough.Add(coin)
+	}
+	return passthrough, extendedAmt
+}
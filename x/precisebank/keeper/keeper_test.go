@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/keeper"
+	"github.com/evmos/os/x/precisebank/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testDenom = "atest"
+
+// mockAccountKeeper returns module accounts with mint and burn permissions.
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(moduleName, authtypes.Minter, authtypes.Burner)
+}
+
+func (mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// mockBankKeeper is an in-memory x/bank keeper that only tracks the balances
+// and the supply of each denom.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.balances[addr.String()].AmountOf(denom))
+}
+
+func (m *mockBankKeeper) IsSendEnabledCoins(_ context.Context, _ ...sdk.Coin) error { return nil }
+
+func (m *mockBankKeeper) BlockedAddr(_ sdk.AccAddress) bool { return false }
+
+func (m *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[fromAddr.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", m.balances[fromAddr.String()], amt)
+	}
+	m.balances[fromAddr.String()] = balance
+	m.balances[toAddr.String()] = m.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return m.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	m.balances[addr] = m.balances[addr].Add(amt...)
+	m.supply = m.supply.Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	if err := m.SendCoins(ctx, authtypes.NewModuleAddress(moduleName), authtypes.NewModuleAddress("burned"), amt); err != nil {
+		return err
+	}
+	m.supply = m.supply.Sub(amt...)
+	return nil
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockBankKeeper) {
+	t.Helper()
+
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo(testDenom, 6).Configure())
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	bk := newMockBankKeeper()
+	k := keeper.NewKeeper(nil, key, bk, mockAccountKeeper{})
+	return ctx, k, bk
+}
+
+// extendedCoins returns the given amount of the evm coin in 18 decimals.
+func extendedCoins(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(amt)))
+}
+
+// requireValidState checks that the invariants hold and that the total supply
+// in 18 decimals matches the expected one.
+func requireValidState(t *testing.T, ctx sdk.Context, k keeper.Keeper, bk *mockBankKeeper, expSupply int64) {
+	t.Helper()
+
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	integerSupply := bk.supply.AmountOf(testDenom).Mul(types.ConversionFactor())
+	supply := integerSupply.Sub(k.GetRemainderAmount(ctx))
+	require.Equal(t, sdkmath.NewInt(expSupply).String(), supply.String())
+}
+
+func TestMintSendBurn(t *testing.T) {
+	ctx, k, bk := setupKeeper(t)
+
+	sender := sdk.AccAddress([]byte("test_sender"))
+	recipient := sdk.AccAddress([]byte("test_recipient"))
+	cf := types.ConversionFactor().Int64()
+
+	// mint 1.5 integer units to the sender
+	require.NoError(t, k.MintCoins(ctx, evmtypes.ModuleName, extendedCoins(cf+cf/2)))
+	require.NoError(t, k.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, sender, extendedCoins(cf+cf/2)))
+	require.Equal(t, sdkmath.NewInt(cf+cf/2), k.GetBalance(ctx, sender, testDenom).Amount)
+	requireValidState(t, ctx, k, bk, cf+cf/2)
+
+	// send 1 wei, which would be truncated in x/bank
+	require.NoError(t, k.SendCoins(ctx, sender, recipient, extendedCoins(1)))
+	require.Equal(t, sdkmath.NewInt(cf+cf/2-1), k.GetBalance(ctx, sender, testDenom).Amount)
+	require.Equal(t, sdkmath.OneInt(), k.GetBalance(ctx, recipient, testDenom).Amount)
+	requireValidState(t, ctx, k, bk, cf+cf/2)
+
+	// send an amount that makes the sender borrow and the recipient carry
+	require.NoError(t, k.SendCoins(ctx, sender, recipient, extendedCoins(cf-1)))
+	require.Equal(t, sdkmath.NewInt(cf/2), k.GetBalance(ctx, sender, testDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(cf), k.GetBalance(ctx, recipient, testDenom).Amount)
+	require.Equal(t, sdkmath.OneInt(), bk.GetBalance(ctx, recipient, testDenom).Amount)
+	requireValidState(t, ctx, k, bk, cf+cf/2)
+
+	// sending more than the balance fails
+	err := k.SendCoins(ctx, sender, recipient, extendedCoins(cf/2+1))
+	require.ErrorContains(t, err, "insufficient funds")
+
+	// burn a fractional amount from the sender
+	require.NoError(t, k.BurnAmountFromAccount(ctx, sender, sdkmath.NewInt(cf/4).BigInt()))
+	require.Equal(t, sdkmath.NewInt(cf/4), k.GetBalance(ctx, sender, testDenom).Amount)
+	requireValidState(t, ctx, k, bk, cf+cf/4)
+
+	// mint a fractional amount to the recipient
+	require.NoError(t, k.MintAmountToAccount(ctx, recipient, sdkmath.NewInt(3).BigInt()))
+	require.Equal(t, sdkmath.NewInt(cf+3), k.GetBalance(ctx, recipient, testDenom).Amount)
+	requireValidState(t, ctx, k, bk, cf+cf/4+3)
+}
+
+func TestSendToSelf(t *testing.T) {
+	ctx, k, bk := setupKeeper(t)
+
+	addr := sdk.AccAddress([]byte("test_address"))
+	cf := types.ConversionFactor().Int64()
+
+	require.NoError(t, k.MintAmountToAccount(ctx, addr, sdkmath.NewInt(cf+1).BigInt()))
+	require.NoError(t, k.SendCoins(ctx, addr, addr, extendedCoins(2)))
+	require.Equal(t, sdkmath.NewInt(cf+1), k.GetBalance(ctx, addr, testDenom).Amount)
+	requireValidState(t, ctx, k, bk, cf+1)
+}
@@ -0,0 +1,113 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// MintCoins creates new coins and adds them to the given module account. The
+// amount of the evm coin is expected in 18 decimals. It panics if the module
+// account does not exist or is not allowed to mint.
+func (k Keeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	passthrough, extendedAmt := splitCoins(amt)
+	if extendedAmt.IsZero() {
+		return k.bk.MintCoins(ctx, moduleName, passthrough)
+	}
+
+	if !amt.IsValid() {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, amt.String())
+	}
+
+	acc := k.ak.GetModuleAccount(ctx, moduleName)
+	if acc == nil {
+		panic(errorsmod.Wrapf(errortypes.ErrUnknownAddress, "module account %s does not exist", moduleName))
+	}
+
+	if !acc.HasPermission(authtypes.Minter) {
+		panic(errorsmod.Wrapf(errortypes.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName))
+	}
+
+	if !passthrough.IsZero() {
+		if err := k.bk.MintCoins(ctx, moduleName, passthrough); err != nil {
+			return err
+		}
+	}
+
+	return k.mintExtendedCoin(sdk.UnwrapSDKContext(ctx), moduleName, acc.GetAddress(), extendedAmt)
+}
+
+// MintAmountToAccount mints the given amount of the evm coin, in 18 decimals,
+// to the provided account.
+func (k Keeper) MintAmountToAccount(ctx context.Context, recipientAddr sdk.AccAddress, amt *big.Int) error {
+	coins := sdk.Coins{{Denom: evmtypes.GetEVMCoinDenom(), Amount: sdkmath.NewIntFromBigInt(amt)}}
+
+	if err := k.MintCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(err, "failed to mint coins to account in precisebank")
+	}
+
+	return k.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, recipientAddr, coins)
+}
+
+// mintExtendedCoin mints an amount of the evm coin in 18 decimals. The integer
+// part is minted through x/bank, while the fractional part is taken from the
+// remainder. A new integer unit is minted to the reserve when the remainder is
+// not enough, and an integer unit is moved from the reserve to the module when
+// its fractional balance overflows.
+func (k Keeper) mintExtendedCoin(ctx sdk.Context, moduleName string, moduleAddr sdk.AccAddress, amt sdkmath.Int) error {
+	denom := evmtypes.GetEVMCoinDenom()
+	cf := types.ConversionFactor()
+	integerAmt, fractionalAmt := types.SplitAmount(amt)
+
+	if fractionalAmt.IsPositive() {
+		moduleFractional := k.GetFractionalBalance(ctx, moduleAddr).Add(fractionalAmt)
+		moduleCarries := moduleFractional.GTE(cf)
+		if moduleCarries {
+			moduleFractional = moduleFractional.Sub(cf)
+		}
+
+		remainder := k.GetRemainderAmount(ctx).Sub(fractionalAmt)
+		reserveMints := remainder.IsNegative()
+		if reserveMints {
+			remainder = remainder.Add(cf)
+		}
+
+		unit := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.OneInt()))
+		switch {
+		case moduleCarries && reserveMints:
+			// the unit minted to the reserve would be moved to the module, so
+			// it is minted directly
+			integerAmt = integerAmt.AddRaw(1)
+		case reserveMints:
+			if err := k.bk.MintCoins(ctx, types.ModuleName, unit); err != nil {
+				return errorsmod.Wrap(err, "failed to mint integer unit to reserve")
+			}
+		case moduleCarries:
+			if err := k.bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, moduleName, unit); err != nil {
+				return errorsmod.Wrap(err, "failed to carry integer unit from reserve")
+			}
+		}
+
+		k.SetFractionalBalance(ctx, moduleAddr, moduleFractional)
+		k.SetRemainderAmount(ctx, remainder)
+	}
+
+	if integerAmt.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, integerAmt))
+		if err := k.bk.MintCoins(ctx, moduleName, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// IsSendEnabledCoins returns an error if any of the coins is not enabled to be
+// sent. The evm coin keeps the same denom in 18 decimals, so the check is
+// delegated to x/bank.
+func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error {
+	return k.bk.IsSendEnabledCoins(ctx, coins...)
+}
+
+// SendCoins transfers the given coins from one account to another. The amount
+// of the evm coin is expected in 18 decimals.
+func (k Keeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, amt.String())
+	}
+
+	passthrough, extendedAmt := splitCoins(amt)
+	if !passthrough.IsZero() {
+		if err := k.bk.SendCoins(ctx, fromAddr, toAddr, passthrough); err != nil {
+			return err
+		}
+	}
+
+	return k.sendExtendedCoin(sdk.UnwrapSDKContext(ctx), fromAddr, toAddr, extendedAmt)
+}
+
+// SendCoinsFromAccountToModule transfers the given coins from an account to a
+// module account. The amount of the evm coin is expected in 18 decimals.
+func (k Keeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	// the module account is created if it doesn't exist yet, like in x/bank,
+	// so that the send does not create a base account at its address
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(errorsmod.Wrapf(errortypes.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// SendCoinsFromModuleToAccount transfers the given coins from a module account
+// to an account. The amount of the evm coin is expected in 18 decimals.
+func (k Keeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	senderAddr := k.ak.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(errorsmod.Wrapf(errortypes.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	if k.bk.BlockedAddr(recipientAddr) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+
+	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+}
+
+// sendExtendedCoin transfers an amount of the evm coin in 18 decimals. The
+// integer part is sent through x/bank while the fractional part is moved
+// between the fractional balances. When the sender fractional balance is not
+// enough, an integer unit is borrowed from its bank balance and deposited in
+// the reserve. When the recipient fractional balance overflows, an integer
+// unit is withdrawn from the reserve.
+func (k Keeper) sendExtendedCoin(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdkmath.Int) error {
+	if amt.IsZero() {
+		return nil
+	}
+
+	denom := evmtypes.GetEVMCoinDenom()
+	if balance := k.GetBalance(ctx, fromAddr, denom).Amount; balance.LT(amt) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"spendable balance %s%s is smaller than %s%s", balance, denom, amt, denom,
+		)
+	}
+
+	cf := types.ConversionFactor()
+	integerAmt, fractionalAmt := types.SplitAmount(amt)
+
+	senderFractional := k.GetFractionalBalance(ctx, fromAddr).Sub(fractionalAmt)
+	senderBorrows := senderFractional.IsNegative()
+	if senderBorrows {
+		senderFractional = senderFractional.Add(cf)
+	}
+	// the sender balance is updated before reading the recipient one to
+	// support transfers to the same account
+	k.SetFractionalBalance(ctx, fromAddr, senderFractional)
+
+	recipientFractional := k.GetFractionalBalance(ctx, toAddr).Add(fractionalAmt)
+	recipientCarries := recipientFractional.GTE(cf)
+	if recipientCarries {
+		recipientFractional = recipientFractional.Sub(cf)
+	}
+	k.SetFractionalBalance(ctx, toAddr, recipientFractional)
+
+	reserveAddr := k.ak.GetModuleAddress(types.ModuleName)
+	switch {
+	case senderBorrows && recipientCarries:
+		// the unit deposited into the reserve by the sender is withdrawn by the
+		// recipient, so it is sent directly
+		integerAmt = integerAmt.AddRaw(1)
+	case senderBorrows:
+		if err := k.sendIntegerUnit(ctx, fromAddr, reserveAddr); err != nil {
+			return errorsmod.Wrap(err, "failed to borrow integer unit from sender")
+		}
+	case recipientCarries:
+		if err := k.sendIntegerUnit(ctx, reserveAddr, toAddr); err != nil {
+			return errorsmod.Wrap(err, "failed to carry integer unit to recipient")
+		}
+	}
+
+	if integerAmt.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, integerAmt))
+		if err := k.bk.SendCoins(ctx, fromAddr, toAddr, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendIntegerUnit sends a single unit of the evm coin in its x/bank
+// representation.
+func (k Keeper) sendIntegerUnit(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress) error {
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.OneInt()))
+	if err := k.bk.SendCoins(ctx, fromAddr, toAddr, coins); err != nil {
+		return fmt.Errorf("failed to send %s from %s to %s: %w", coins, fromAddr, toAddr, err)
+	}
+	return nil
+}
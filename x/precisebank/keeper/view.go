@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+)
+
+// GetBalance returns the balance of the given account. The balance of the evm
+// coin is returned in 18 decimals, including its fractional part.
+func (k Keeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	coin := k.bk.GetBalance(ctx, addr, denom)
+	if !isFractionalDenom(denom) {
+		return coin
+	}
+
+	return sdk.NewCoin(denom, k.getExtendedBalance(sdk.UnwrapSDKContext(ctx), addr, coin.Amount))
+}
+
+// GetAllBalances returns all the balances of the given account. The balance of
+// the evm coin is returned in 18 decimals, including its fractional part.
+func (k Keeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	balances := k.bk.GetAllBalances(ctx, addr)
+
+	denom := evmtypes.GetEVMCoinDenom()
+	if !isFractionalDenom(denom) {
+		return balances
+	}
+
+	extendedAmt := k.getExtendedBalance(sdk.UnwrapSDKContext(ctx), addr, balances.AmountOf(denom))
+
+	// replace the integer balance of the evm coin with the extended one
+	extendedBalances := sdk.NewCoins(sdk.NewCoin(denom, extendedAmt))
+	for _, coin := range balances {
+		if coin.Denom != denom {
+			extendedBalances = extendedBalances.Add(coin)
+		}
+	}
+	return extendedBalances
+}
+
+// getExtendedBalance returns the balance in 18 decimals of the given account
+// from the integer amount stored in x/bank and the fractional balance.
+func (k Keeper) getExtendedBalance(ctx sdk.Context, addr sdk.AccAddress, integerAmt sdkmath.Int) sdkmath.Int {
+	fractionalAmt := k.GetFractionalBalance(ctx, addr)
+	return integerAmt.Mul(types.ConversionFactor()).Add(fractionalAmt)
+}
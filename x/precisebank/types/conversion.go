@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	evmtypes "github.com/evmos/os/x/evm/types"
+)
+
+// ConversionFactor returns the factor between the 18 decimals representation
+// of the evm coin used by the EVM and its representation in x/bank. It is the
+// exclusive upper bound of any fractional balance.
+func ConversionFactor() sdkmath.Int {
+	return evmtypes.GetEVMCoinDecimals().ConversionFactor()
+}
+
+// SplitAmount splits an amount in 18 decimals into its integer part, in the
+// x/bank representation, and its fractional part, in 18 decimals.
+func SplitAmount(amt sdkmath.Int) (integer, fractional sdkmath.Int) {
+	cf := ConversionFactor()
+	return amt.Quo(cf), amt.Mod(cf)
+}
@@ -0,0 +1,76 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FractionalBalances is a slice of FractionalBalance.
+type FractionalBalances []FractionalBalance
+
+// NewFractionalBalance returns a new FractionalBalance.
+func NewFractionalBalance(address string, amount sdkmath.Int) FractionalBalance {
+	return FractionalBalance{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+// Validate returns an error if the address is not a valid bech32 address or
+// the amount is not a valid fractional amount.
+func (fb FractionalBalance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(fb.Address); err != nil {
+		return fmt.Errorf("invalid address %q: %w", fb.Address, err)
+	}
+
+	return ValidateFractionalAmount(fb.Amount)
+}
+
+// ValidateFractionalAmount returns an error if the amount is not positive or
+// is not lower than the conversion factor. Zero fractional balances are never
+// stored.
+func ValidateFractionalAmount(amt sdkmath.Int) error {
+	if amt.IsNil() || !amt.IsPositive() {
+		return fmt.Errorf("non-positive fractional amount: %s", amt)
+	}
+
+	if amt.GTE(ConversionFactor()) {
+		return fmt.Errorf("fractional amount %s exceeds max %s", amt, ConversionFactor().SubRaw(1))
+	}
+
+	return nil
+}
+
+// Validate returns an error if any of the balances is invalid or if an
+// address is duplicated.
+func (fbs FractionalBalances) Validate() error {
+	seen := make(map[string]struct{}, len(fbs))
+	for _, fb := range fbs {
+		if err := fb.Validate(); err != nil {
+			return err
+		}
+
+		// normalize the address to catch duplicates with different casing
+		addr := sdk.MustAccAddressFromBech32(fb.Address).String()
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// SumAmount returns the sum of all the fractional balances.
+func (fbs FractionalBalances) SumAmount() sdkmath.Int {
+	sum := sdkmath.ZeroInt()
+	for _, fb := range fbs {
+		sum = sum.Add(fb.Amount)
+	}
+	return sum
+}
@@ -0,0 +1,62 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultGenesisState returns the default precisebank genesis state, without
+// fractional balances.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(FractionalBalances{}, sdkmath.ZeroInt())
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(balances FractionalBalances, remainder sdkmath.Int) *GenesisState {
+	return &GenesisState{
+		Balances:  balances,
+		Remainder: remainder,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. The sum of the fractional balances and the remainder has to be a
+// multiple of the conversion factor, so that it can be fully backed by the
+// reserve.
+func (gs GenesisState) Validate() error {
+	if err := gs.Balances.Validate(); err != nil {
+		return fmt.Errorf("invalid balances: %w", err)
+	}
+
+	if gs.Remainder.IsNil() {
+		return fmt.Errorf("nil remainder amount")
+	}
+
+	if gs.Remainder.IsNegative() {
+		return fmt.Errorf("negative remainder amount %s", gs.Remainder)
+	}
+
+	if gs.Remainder.GTE(ConversionFactor()) {
+		return fmt.Errorf("remainder %s exceeds max %s", gs.Remainder, ConversionFactor().SubRaw(1))
+	}
+
+	total := gs.TotalAmountWithRemainder()
+	if !total.Mod(ConversionFactor()).IsZero() {
+		return fmt.Errorf(
+			"sum of fractional balances with remainder %s is not a multiple of %s",
+			total, ConversionFactor(),
+		)
+	}
+
+	return nil
+}
+
+// TotalAmountWithRemainder returns the sum of all the fractional balances and
+// the remainder, which has to match the reserve balance in 18 decimals.
+func (gs GenesisState) TotalAmountWithRemainder() sdkmath.Int {
+	return gs.Balances.SumAmount().Add(gs.Remainder)
+}
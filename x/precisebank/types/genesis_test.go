@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/os/x/evm/types"
+	"github.com/evmos/os/x/precisebank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo("atest", 6).Configure())
+
+	addr1 := sdk.AccAddress([]byte("test_address_1")).String()
+	addr2 := sdk.AccAddress([]byte("test_address_2")).String()
+	cf := types.ConversionFactor()
+
+	testCases := []struct {
+		name      string
+		genState  *types.GenesisState
+		expErrMsg string
+	}{
+		{
+			"pass - default",
+			types.DefaultGenesisState(),
+			"",
+		},
+		{
+			"pass - balances backed by the reserve",
+			types.NewGenesisState(
+				types.FractionalBalances{
+					types.NewFractionalBalance(addr1, cf.QuoRaw(2)),
+					types.NewFractionalBalance(addr2, cf.QuoRaw(4)),
+				},
+				cf.QuoRaw(4),
+			),
+			"",
+		},
+		{
+			"fail - zero balance",
+			types.NewGenesisState(
+				types.FractionalBalances{types.NewFractionalBalance(addr1, sdkmath.ZeroInt())},
+				sdkmath.ZeroInt(),
+			),
+			"non-positive fractional amount",
+		},
+		{
+			"fail - balance exceeds conversion factor",
+			types.NewGenesisState(
+				types.FractionalBalances{types.NewFractionalBalance(addr1, cf)},
+				sdkmath.ZeroInt(),
+			),
+			"exceeds max",
+		},
+		{
+			"fail - invalid address",
+			types.NewGenesisState(
+				types.FractionalBalances{types.NewFractionalBalance("invalid", sdkmath.OneInt())},
+				cf.SubRaw(1),
+			),
+			"invalid address",
+		},
+		{
+			"fail - duplicate address",
+			types.NewGenesisState(
+				types.FractionalBalances{
+					types.NewFractionalBalance(addr1, cf.QuoRaw(2)),
+					types.NewFractionalBalance(addr1, cf.QuoRaw(2)),
+				},
+				sdkmath.ZeroInt(),
+			),
+			"duplicate address",
+		},
+		{
+			"fail - negative remainder",
+			types.NewGenesisState(types.FractionalBalances{}, sdkmath.NewInt(-1)),
+			"negative remainder",
+		},
+		{
+			"fail - remainder exceeds conversion factor",
+			types.NewGenesisState(types.FractionalBalances{}, cf),
+			"exceeds max",
+		},
+		{
+			"fail - total not a multiple of the conversion factor",
+			types.NewGenesisState(
+				types.FractionalBalances{types.NewFractionalBalance(addr1, sdkmath.OneInt())},
+				sdkmath.ZeroInt(),
+			),
+			"is not a multiple of",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "precisebank"
+
+	// StoreKey key for the fractional balances and the remainder amount.
+	StoreKey = ModuleName
+)
+
+// prefix bytes for the precisebank persistent store
+const (
+	prefixFractionalBalance = iota + 1
+	prefixRemainderAmount
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixFractionalBalance = []byte{prefixFractionalBalance}
+	KeyRemainderAmount         = []byte{prefixRemainderAmount}
+)
+
+// FractionalBalanceKey returns the store key of the fractional balance of the
+// given address, relative to the fractional balance prefix.
+func FractionalBalanceKey(addr sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(addr)
+}
@@ -0,0 +1,941 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: os/precisebank/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryRemainderRequest defines the request type for Query/Remainder.
+type QueryRemainderRequest struct {
+}
+
+func (m *QueryRemainderRequest) Reset()         { *m = QueryRemainderRequest{} }
+func (m *QueryRemainderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainderRequest) ProtoMessage()    {}
+func (*QueryRemainderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de055c7827e4a1ec, []int{0}
+}
+func (m *QueryRemainderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainderRequest.Merge(m, src)
+}
+func (m *QueryRemainderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainderRequest proto.InternalMessageInfo
+
+// QueryRemainderResponse defines the response type for Query/Remainder.
+type QueryRemainderResponse struct {
+	// remainder is the amount backed by the reserve that is not assigned to any
+	// account, in 18 decimals.
+	Remainder types.Coin `protobuf:"bytes,1,opt,name=remainder,proto3" json:"remainder"`
+}
+
+func (m *QueryRemainderResponse) Reset()         { *m = QueryRemainderResponse{} }
+func (m *QueryRemainderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainderResponse) ProtoMessage()    {}
+func (*QueryRemainderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de055c7827e4a1ec, []int{1}
+}
+func (m *QueryRemainderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainderResponse.Merge(m, src)
+}
+func (m *QueryRemainderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainderResponse proto.InternalMessageInfo
+
+func (m *QueryRemainderResponse) GetRemainder() types.Coin {
+	if m != nil {
+		return m.Remainder
+	}
+	return types.Coin{}
+}
+
+// QueryFractionalBalanceRequest defines the request type for
+// Query/FractionalBalance.
+type QueryFractionalBalanceRequest struct {
+	// address is the bech32 address of the account to query.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFractionalBalanceRequest) Reset()         { *m = QueryFractionalBalanceRequest{} }
+func (m *QueryFractionalBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFractionalBalanceRequest) ProtoMessage()    {}
+func (*QueryFractionalBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de055c7827e4a1ec, []int{2}
+}
+func (m *QueryFractionalBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFractionalBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFractionalBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFractionalBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFractionalBalanceRequest.Merge(m, src)
+}
+func (m *QueryFractionalBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFractionalBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFractionalBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFractionalBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryFractionalBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFractionalBalanceResponse defines the response type for
+// Query/FractionalBalance.
+type QueryFractionalBalanceResponse struct {
+	// fractional_balance is the fractional balance of the account, in 18
+	// decimals.
+	FractionalBalance types.Coin `protobuf:"bytes,1,opt,name=fractional_balance,json=fractionalBalance,proto3" json:"fractional_balance"`
+}
+
+func (m *QueryFractionalBalanceResponse) Reset()         { *m = QueryFractionalBalanceResponse{} }
+func (m *QueryFractionalBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFractionalBalanceResponse) ProtoMessage()    {}
+func (*QueryFractionalBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de055c7827e4a1ec, []int{3}
+}
+func (m *QueryFractionalBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFractionalBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFractionalBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFractionalBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFractionalBalanceResponse.Merge(m, src)
+}
+func (m *QueryFractionalBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFractionalBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFractionalBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFractionalBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryFractionalBalanceResponse) GetFractionalBalance() types.Coin {
+	if m != nil {
+		return m.FractionalBalance
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*QueryRemainderRequest)(nil), "os.precisebank.v1.QueryRemainderRequest")
+	proto.RegisterType((*QueryRemainderResponse)(nil), "os.precisebank.v1.QueryRemainderResponse")
+	proto.RegisterType((*QueryFractionalBalanceRequest)(nil), "os.precisebank.v1.QueryFractionalBalanceRequest")
+	proto.RegisterType((*QueryFractionalBalanceResponse)(nil), "os.precisebank.v1.QueryFractionalBalanceResponse")
+}
+
+func init() { proto.RegisterFile("os/precisebank/v1/query.proto", fileDescriptor_de055c7827e4a1ec) }
+
+var fileDescriptor_de055c7827e4a1ec = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0x29, 0xa8, 0xec, 0x78, 0xda, 0xa1, 0x6a, 0x1b, 0xda, 0x51, 0x82, 0x60, 0x15, 0x9c,
+	0x31, 0xeb, 0xc1, 0x73, 0x23, 0x78, 0x37, 0x7b, 0x13, 0xa1, 0x4c, 0xb2, 0xd3, 0x38, 0xb8, 0x99,
+	0x97, 0xce, 0x4c, 0x82, 0x45, 0xbc, 0x78, 0xf0, 0x2c, 0xf8, 0x27, 0x3c, 0x2a, 0xf8, 0x13, 0x3c,
+	0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x64, 0x57, 0xf0, 0x6f, 0x48, 0x92, 0xe9, 0xaa, 0x4d, 0x17, 0xec,
+	0x25, 0x64, 0xde, 0xf7, 0xde, 0xfb, 0xbe, 0xf9, 0xde, 0x1b, 0xbc, 0x0d, 0x96, 0x97, 0x46, 0x66,
+	0xca, 0xca, 0x54, 0xe8, 0xe7, 0xbc, 0x8e, 0xf8, 0x41, 0x25, 0xcd, 0x21, 0x2b, 0x0d, 0x38, 0x20,
+	0x23, 0xb0, 0xec, 0x2f, 0x98, 0xd5, 0x51, 0x30, 0x12, 0x85, 0xd2, 0xc0, 0xdb, 0x6f, 0x97, 0x15,
+	0xd0, 0x0c, 0x6c, 0x01, 0x96, 0xa7, 0xc2, 0x4a, 0x5e, 0x47, 0xa9, 0x74, 0x22, 0xe2, 0x19, 0x28,
+	0xed, 0xf1, 0xcd, 0x0e, 0xdf, 0x6b, 0x4f, 0xbc, 0x3b, 0x78, 0x68, 0x3d, 0x87, 0x1c, 0xba, 0x78,
+	0xf3, 0xe7, 0xa3, 0x5b, 0x39, 0x40, 0x3e, 0x93, 0x5c, 0x94, 0x8a, 0x0b, 0xad, 0xc1, 0x09, 0xa7,
+	0x40, 0xfb, 0x9a, 0xf0, 0x1a, 0xbe, 0xf2, 0xb8, 0xd1, 0x98, 0xc8, 0x42, 0x28, 0x3d, 0x95, 0x26,
+	0x91, 0x07, 0x95, 0xb4, 0x2e, 0x7c, 0x8a, 0xaf, 0x9e, 0x06, 0x6c, 0x09, 0xda, 0x4a, 0x12, 0xe3,
+	0xa1, 0x39, 0x09, 0x6e, 0xa0, 0x1b, 0x68, 0xe7, 0xf2, 0x78, 0x93, 0x79, 0x21, 0x8d, 0x6a, 0xe6,
+	0x55, 0xb3, 0x87, 0xa0, 0x74, 0x3c, 0x3c, 0xfa, 0x7e, 0x7d, 0xf0, 0xfe, 0xd7, 0x87, 0x3b, 0x28,
+	0xf9, 0x53, 0x16, 0x4e, 0xf0, 0x76, 0xdb, 0xfd, 0x91, 0x11, 0x59, 0x23, 0x47, 0xcc, 0x62, 0x31,
+	0x13, 0x3a, 0x93, 0x9e, 0x9e, 0x8c, 0xf1, 0x25, 0x31, 0x9d, 0x1a, 0x69, 0x6d, 0x4b, 0x31, 0x8c,
+	0x37, 0xbe, 0x7c, 0xba, 0xbb, 0xee, 0x59, 0x76, 0x3b, 0x64, 0xe2, 0x8c, 0xd2, 0x79, 0x72, 0x92,
+	0x18, 0x56, 0x98, 0xae, 0x6a, 0xea, 0xa5, 0x4f, 0x30, 0xd9, 0x5f, 0x82, 0x7b, 0x69, 0x87, 0x9e,
+	0xeb, 0x0e, 0xa3, 0xfd, 0xd3, 0xcd, 0xc7, 0x9f, 0xd7, 0xf0, 0x85, 0x96, 0x97, 0xbc, 0x41, 0x78,
+	0xb8, 0xf4, 0x8b, 0xec, 0xb0, 0xde, 0xc0, 0xd9, 0x99, 0x5e, 0x07, 0xb7, 0xff, 0x23, 0xb3, 0xbb,
+	0x41, 0x78, 0xf3, 0xf5, 0xd7, 0x9f, 0xef, 0xd6, 0x28, 0xd9, 0xe2, 0xfd, 0x65, 0x5b, 0xda, 0x4b,
+	0x3e, 0x22, 0x3c, 0xea, 0xb9, 0x40, 0xee, 0xad, 0xa2, 0x59, 0x35, 0x85, 0x20, 0x3a, 0x47, 0x85,
+	0x17, 0xf8, 0xa0, 0x15, 0x18, 0x11, 0x7e, 0x86, 0xc0, 0xbe, 0xf7, 0xfc, 0xa5, 0x1f, 0xde, 0xab,
+	0x78, 0xf7, 0x68, 0x4e, 0xd1, 0xf1, 0x9c, 0xa2, 0x1f, 0x73, 0x8a, 0xde, 0x2e, 0xe8, 0xe0, 0x78,
+	0x41, 0x07, 0xdf, 0x16, 0x74, 0xf0, 0xe4, 0x56, 0xae, 0xdc, 0xb3, 0x2a, 0x65, 0x19, 0x14, 0x5c,
+	0xd6, 0xcd, 0xe3, 0x00, 0xcb, 0x5f, 0xfc, 0xd3, 0xdc, 0x1d, 0x96, 0xd2, 0xa6, 0x17, 0xdb, 0x9d,
+	0xbe, 0xff, 0x7b, 0x00, 0x96, 0xb0, 0xe1, 0x8b, 0x89, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Remainder returns the amount backed by the reserve that is not assigned
+	// to any account.
+	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
+	// FractionalBalance returns the fractional balance of the evm coin of an
+	// account.
+	FractionalBalance(ctx context.Context, in *QueryFractionalBalanceRequest, opts ...grpc.CallOption) (*QueryFractionalBalanceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
+	out := new(QueryRemainderResponse)
+	err := c.cc.Invoke(ctx, "/os.precisebank.v1.Query/Remainder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FractionalBalance(ctx context.Context, in *QueryFractionalBalanceRequest, opts ...grpc.CallOption) (*QueryFractionalBalanceResponse, error) {
+	out := new(QueryFractionalBalanceResponse)
+	err := c.cc.Invoke(ctx, "/os.precisebank.v1.Query/FractionalBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Remainder returns the amount backed by the reserve that is not assigned
+	// to any account.
+	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
+	// FractionalBalance returns the fractional balance of the evm coin of an
+	// account.
+	FractionalBalance(context.Context, *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Remainder(ctx context.Context, req *QueryRemainderRequest) (*QueryRemainderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
+}
+func (*UnimplementedQueryServer) FractionalBalance(ctx context.Context, req *QueryFractionalBalanceRequest) (*QueryFractionalBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FractionalBalance not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Remainder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/os.precisebank.v1.Query/Remainder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Remainder(ctx, req.(*QueryRemainderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FractionalBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFractionalBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FractionalBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/os.precisebank.v1.Query/FractionalBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FractionalBalance(ctx, req.(*QueryFractionalBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "os.precisebank.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Remainder",
+			Handler:    _Query_Remainder_Handler,
+		},
+		{
+			MethodName: "FractionalBalance",
+			Handler:    _Query_FractionalBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "os/precisebank/v1/query.proto",
+}
+
+func (m *QueryRemainderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Remainder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFractionalBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFractionalBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFractionalBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFractionalBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFractionalBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFractionalBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FractionalBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRemainderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRemainderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remainder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFractionalBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFractionalBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FractionalBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFractionalBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFractionalBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFractionalBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FractionalBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FractionalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)